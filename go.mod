@@ -2,7 +2,4 @@ module github.com/bvisness/jamtech
 
 go 1.17
 
-require (
-	github.com/gen2brain/raylib-go/raygui v0.0.0-20210906160657-aabc97d1c242 // indirect
-	github.com/gen2brain/raylib-go/raylib v0.0.0-20210906160657-aabc97d1c242 // indirect
-)
+require github.com/gen2brain/raylib-go/raylib v0.0.0-20210906160657-aabc97d1c242
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	gui2 "github.com/bvisness/jamtech/raylib/raygui"
-	gui "github.com/gen2brain/raylib-go/raygui"
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
 
@@ -33,26 +32,20 @@ var selectedColor = 0
 
 var ballLabel = "Ball"
 
-var textBoxActive = false
-var dropdownOpen = false
-
 func doFrame() {
 	rl.BeginDrawing()
 	defer rl.EndDrawing()
 
-	ballPosition.X = gui.SliderBar(rl.Rectangle{600, 40, 120, 20}, ballPosition.X, 0, screenWidth)
-	ballPosition.Y = gui.SliderBar(rl.Rectangle{600, 70, 120, 20}, ballPosition.Y, 0, screenHeight)
+	gui2.BeginFrame()
 
-	var toggleTextBox bool
-	if ballLabel, toggleTextBox = gui2.TextBox(rl.Rectangle{40, 40, 120, 20}, ballLabel, 100, textBoxActive); toggleTextBox {
-		textBoxActive = !textBoxActive
-	}
+	ballPosition.X = gui2.SliderBar(rl.Rectangle{600, 40, 120, 20}, "", "", ballPosition.X, 0, screenWidth)
+	ballPosition.Y = gui2.SliderBar(rl.Rectangle{600, 70, 120, 20}, "", "", ballPosition.Y, 0, screenHeight)
+
+	ballLabel, _ = gui2.TextBoxID(gui2.NewID("ball label"), rl.Rectangle{40, 40, 120, 20}, ballLabel, 100)
 
 	//selectedColor = gui.ComboBox(rl.Rectangle{40, 40, 120, 20}, colors, selectedColor)
 	selectedColor = gui2.ComboBox(rl.Rectangle{40, 70, 120, 20}, colors, selectedColor)
-	if gui2.DropdownBox(rl.Rectangle{40, 100, 120, 20}, colors, &selectedColor, dropdownOpen) {
-		dropdownOpen = !dropdownOpen
-	}
+	gui2.DropdownBoxID(gui2.NewID("ball color"), rl.Rectangle{40, 100, 120, 20}, colors, &selectedColor)
 	//ballLabel = gui.TextBox(rl.Rectangle{40, 70, 120, 20}, ballLabel)
 
 	rl.ClearBackground(rl.RayWhite)
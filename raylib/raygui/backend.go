@@ -0,0 +1,161 @@
+package raygui
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Input and drawing functions required by raygui
+// NOTE: Equivalent to the functions raygui.h requires in RAYGUI_STANDALONE mode,
+// signatures follow raylib so RaylibBackend can forward every call directly.
+// Style and icon file loading still use raylib to load fonts and textures.
+type Backend interface {
+	// Input required functions
+	GetMousePosition() rl.Vector2
+	GetMouseWheelMove() int32
+	IsMouseButtonDown(button int32) bool
+	IsMouseButtonPressed(button int32) bool
+	IsMouseButtonReleased(button int32) bool
+
+	IsKeyDown(key int32) bool
+	IsKeyPressed(key int32) bool
+	GetCharPressed() int32 // -- TextBox(), TextBoxMulti(), ValueBox()
+
+	IsGamepadAvailable(gamepad int32) bool             // -- Gamepad navigation
+	IsGamepadButtonPressed(gamepad, button int32) bool // -- Gamepad navigation
+
+	// Clipboard required functions
+	GetClipboardText() string     // -- TextBox()
+	SetClipboardText(text string) // -- TextBox()
+
+	// Timing required functions
+	GetTime() float32      // -- DragFloat()
+	GetFrameTime() float32 // -- Spinner(), ProgressBarIndeterminate()
+
+	// Drawing required functions
+	DrawRectangle(posX, posY, width, height int32, color rl.Color)                                   // -- DrawRectangle(), DrawIcon()
+	DrawRectangleGradientV(posX, posY, width, height int32, color1, color2 rl.Color)                 // -- ColorBarAlpha(), ColorBarHue()
+	DrawRectangleGradientEx(rec rl.Rectangle, color1, color2, color3, color4 rl.Color)               // -- ColorPanelEx(), ColorBarAlpha()
+	DrawTriangle(v1, v2, v3 rl.Vector2, color rl.Color)                                              // -- DropdownBox(), ScrollBar()
+	DrawTextureRec(texture rl.Texture2D, sourceRec rl.Rectangle, position rl.Vector2, tint rl.Color) // -- ImageButtonEx()
+	BeginScissorMode(x, y, width, height int32)                                                      // -- TextBoxMulti()
+	EndScissorMode()
+
+	// Text required functions
+	GetFontDefault() rl.Font                                                                                     // -- LoadStyleDefault()
+	MeasureTextEx(font rl.Font, text string, fontSize float32, spacing float32) rl.Vector2                       // -- GetTextWidth(), TextBoxMulti()
+	DrawTextEx(font rl.Font, text string, position rl.Vector2, fontSize float32, spacing float32, tint rl.Color) // -- DrawText()
+}
+
+// Default backend, forwards every call to raylib
+type RaylibBackend struct{}
+
+var _ Backend = RaylibBackend{}
+
+func (RaylibBackend) GetMousePosition() rl.Vector2 {
+	return rl.GetMousePosition()
+}
+
+func (RaylibBackend) GetMouseWheelMove() int32 {
+	return rl.GetMouseWheelMove()
+}
+
+func (RaylibBackend) IsMouseButtonDown(button int32) bool {
+	return rl.IsMouseButtonDown(button)
+}
+
+func (RaylibBackend) IsMouseButtonPressed(button int32) bool {
+	return rl.IsMouseButtonPressed(button)
+}
+
+func (RaylibBackend) IsMouseButtonReleased(button int32) bool {
+	return rl.IsMouseButtonReleased(button)
+}
+
+func (RaylibBackend) IsKeyDown(key int32) bool {
+	return rl.IsKeyDown(key)
+}
+
+func (RaylibBackend) IsKeyPressed(key int32) bool {
+	return rl.IsKeyPressed(key)
+}
+
+func (RaylibBackend) GetCharPressed() int32 {
+	return rl.GetCharPressed()
+}
+
+func (RaylibBackend) IsGamepadAvailable(gamepad int32) bool {
+	return rl.IsGamepadAvailable(gamepad)
+}
+
+func (RaylibBackend) IsGamepadButtonPressed(gamepad, button int32) bool {
+	return rl.IsGamepadButtonPressed(gamepad, button)
+}
+
+func (RaylibBackend) GetClipboardText() string {
+	return rl.GetClipboardText()
+}
+
+func (RaylibBackend) SetClipboardText(text string) {
+	rl.SetClipboardText(text)
+}
+
+func (RaylibBackend) GetTime() float32 {
+	return rl.GetTime()
+}
+
+func (RaylibBackend) GetFrameTime() float32 {
+	return rl.GetFrameTime()
+}
+
+func (RaylibBackend) DrawRectangle(posX, posY, width, height int32, color rl.Color) {
+	rl.DrawRectangle(posX, posY, width, height, color)
+}
+
+func (RaylibBackend) DrawRectangleGradientV(posX, posY, width, height int32, color1, color2 rl.Color) {
+	rl.DrawRectangleGradientV(posX, posY, width, height, color1, color2)
+}
+
+func (RaylibBackend) DrawRectangleGradientEx(rec rl.Rectangle, color1, color2, color3, color4 rl.Color) {
+	rl.DrawRectangleGradientEx(rec, color1, color2, color3, color4)
+}
+
+func (RaylibBackend) DrawTriangle(v1, v2, v3 rl.Vector2, color rl.Color) {
+	rl.DrawTriangle(v1, v2, v3, color)
+}
+
+func (RaylibBackend) DrawTextureRec(texture rl.Texture2D, sourceRec rl.Rectangle, position rl.Vector2, tint rl.Color) {
+	rl.DrawTextureRec(texture, sourceRec, position, tint)
+}
+
+func (RaylibBackend) BeginScissorMode(x, y, width, height int32) {
+	rl.BeginScissorMode(x, y, width, height)
+}
+
+func (RaylibBackend) EndScissorMode() {
+	rl.EndScissorMode()
+}
+
+func (RaylibBackend) GetFontDefault() rl.Font {
+	return rl.GetFontDefault()
+}
+
+func (RaylibBackend) MeasureTextEx(font rl.Font, text string, fontSize float32, spacing float32) rl.Vector2 {
+	return rl.MeasureTextEx(font, text, fontSize, spacing)
+}
+
+func (RaylibBackend) DrawTextEx(font rl.Font, text string, position rl.Vector2, fontSize float32, spacing float32, tint rl.Color) {
+	rl.DrawTextEx(font, text, position, fontSize, spacing, tint)
+}
+
+// Set gui input and drawing backend, nil restores the raylib backend
+func (ctx *Context) SetBackend(backend Backend) {
+	if backend == nil {
+		backend = RaylibBackend{}
+	}
+	ctx.backend = backend
+}
+
+// Get gui input and drawing backend
+func (ctx *Context) GetBackend() Backend {
+	return ctx.backend
+}
@@ -0,0 +1,31 @@
+package raygui
+
+/*
+#include <stdlib.h>
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Create font with glyph rectangles and info copied into C memory
+// NOTE: raylib reads them from C, and UnloadFont() frees them as memory
+// allocated by raylib, so they can't be kept in Go slices
+func newFont(baseSize int32, texture rl.Texture2D, recs []rl.Rectangle, chars []rl.CharInfo) rl.Font {
+	cRecs := (*rl.Rectangle)(C.malloc(C.size_t(len(recs)) * C.size_t(unsafe.Sizeof(rl.Rectangle{}))))
+	copy(unsafe.Slice(cRecs, len(recs)), recs)
+
+	cChars := (*rl.CharInfo)(C.malloc(C.size_t(len(chars)) * C.size_t(unsafe.Sizeof(rl.CharInfo{}))))
+	copy(unsafe.Slice(cChars, len(chars)), chars)
+
+	return rl.Font{
+		BaseSize:   baseSize,
+		CharsCount: int32(len(chars)),
+		Texture:    texture,
+		Recs:       cRecs,
+		Chars:      cChars,
+	}
+}
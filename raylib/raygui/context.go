@@ -0,0 +1,531 @@
+package raygui
+
+import (
+	"io"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Gui context, holds all the state shared by controls
+// NOTE: raygui.h keeps this state in global variables, package level functions
+// use a default context to keep that behaviour. Independent contexts can be
+// used to draw several guis with different styles.
+// WARNING: raylib must be called from the main thread, so only contexts using
+// their own HeadlessBackend or SoftwareBackend can be used from different goroutines,
+// and not while loading styles with fonts, which loads them with raylib
+type Context struct {
+	state  ControlState
+	font   rl.Font // Gui current font (WARNING: highly coupled to raylib)
+	locked bool    // Gui lock state (no inputs processed)
+	alpha  float32 // Gui element transpacency on drawing
+
+	// Gui style array
+	// NOTE: In raygui we manage a single int array with all the possible style properties.
+	// When a new style is loaded, it loads over the context style... but default gui style
+	// could always be recovered with LoadStyleDefault()
+	style       [MaxControls * (MaxPropsDefault + MaxPropsExtended)]uint
+	styleLoaded bool // Style loaded flag for lazy style initialization
+
+	icons [RIconMaxIcons * RIconDataElements]uint32 // Gui icons data
+
+	backend Backend // Gui input and drawing backend
+	frame   uint64  // Frames begun with BeginFrame(), 0 if never called
+
+	spinnerHeldBounds rl.Rectangle // Bounds of the spinner arrow currently held down
+	spinnerHeldTime   float32      // Time the spinner arrow has been held down
+	spinnerRepeated   bool         // Spinner arrow has already repeated during this hold
+
+	marqueeTime float32 // Time the indeterminate progress bar segment has moved in its period
+
+	dropdownBounds rl.Rectangle // Bounds of the dropdown box in edit mode
+	dropdownActive int          // Active item when the dropdown box was opened, restored by gamepad B
+
+	// Used to enable text edit mode
+	// WARNING: No more than one TextInputBox() should be open at the same time
+	textInputBoxEditMode bool
+
+	textEdit textEdit // Cursor and selection of the text box in edit mode
+
+	floatEdit floatEdit // Text of the float value control in edit mode
+	floatDrag floatDrag // Drag state of the drag float control dragged last
+
+	splitResult [TextSplitMaxTextElements]string // TextSplit() result
+	splitBuffer [TextSplitMaxTextLength]byte     // TextSplit() text buffer
+
+	hotID     ID              // Control under the mouse
+	activeID  ID              // Control being interacted with
+	focusedID ID              // Control in edit mode
+	ids       map[ID]*idState // ID-based controls retained state
+
+	focus         focusKey   // Control with keyboard focus
+	focusFrame    []focusKey // Focusable controls drawn in current frame
+	focusPrev     []focusKey // Focusable controls drawn in previous frame
+	focusEditing  bool       // Control with keyboard focus is in edit mode
+	focusDisabled bool       // Controls drawn as part of another control are not focusable
+
+	undos        map[undoKey]*undoStack // Undo stacks of controls without ID
+	undoID       ID                     // ID-based control being drawn, uses its own undo stack
+	undoDisabled bool                   // Controls drawn as part of another control don't record edits
+	undoHandler  UndoHandler            // Application undo history handler
+}
+
+// Create gui context with default icons and raylib backend
+// NOTE: Default style is lazily loaded on first use
+func NewContext() *Context {
+	return &Context{
+		state:   StateNormal,
+		alpha:   1,
+		icons:   defaultIcons,
+		backend: RaylibBackend{},
+	}
+}
+
+// Get default gui context, used by package level functions
+func DefaultContext() *Context {
+	return defaultContext
+}
+
+//----------------------------------------------------------------------------------
+// Default Context Functions Definition
+//----------------------------------------------------------------------------------
+
+// Begin gui frame, call it once every frame before drawing any control
+func BeginFrame() {
+	defaultContext.BeginFrame()
+}
+
+// Enable gui global state
+func Enable() {
+	defaultContext.Enable()
+}
+
+// Disable gui global state
+func Disable() {
+	defaultContext.Disable()
+}
+
+// Lock gui global state
+func Lock() {
+	defaultContext.Lock()
+}
+
+// Unlock gui global state
+func Unlock() {
+	defaultContext.Unlock()
+}
+
+// Set gui controls alpha global state
+func Fade(alpha float32) {
+	defaultContext.Fade(alpha)
+}
+
+// Set gui state (global state)
+func SetState(state ControlState) {
+	defaultContext.SetState(state)
+}
+
+// Get gui state (global state)
+func GetState() ControlState {
+	return defaultContext.GetState()
+}
+
+// Set custom gui font
+func SetFont(font rl.Font) {
+	defaultContext.SetFont(font)
+}
+
+// Get custom gui font
+func GetFont() rl.Font {
+	return defaultContext.GetFont()
+}
+
+// Set control style property value
+func SetStyle(control Control, property ControlProperty, value uint) {
+	defaultContext.SetStyle(control, property, value)
+}
+
+// Get control style property value
+func GetStyle(control Control, property ControlProperty) uint {
+	return defaultContext.GetStyle(control, property)
+}
+
+// Window Box control
+func WindowBox(bounds rl.Rectangle, title string) bool {
+	return defaultContext.WindowBox(bounds, title)
+}
+
+// Group Box control with text name
+func GroupBox(bounds rl.Rectangle, text string) {
+	defaultContext.GroupBox(bounds, text)
+}
+
+// Line control
+func Line(bounds rl.Rectangle, text string) {
+	defaultContext.Line(bounds, text)
+}
+
+// Panel control
+func Panel(bounds rl.Rectangle) {
+	defaultContext.Panel(bounds)
+}
+
+// Scroll Panel control
+func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle {
+	return defaultContext.ScrollPanel(bounds, content, scroll)
+}
+
+// Label control
+func Label(bounds rl.Rectangle, text string) {
+	defaultContext.Label(bounds, text)
+}
+
+// Button control, returns true when clicked
+func Button(bounds rl.Rectangle, text string) bool {
+	return defaultContext.Button(bounds, text)
+}
+
+// Label button control
+func LabelButton(bounds rl.Rectangle, text string) bool {
+	return defaultContext.LabelButton(bounds, text)
+}
+
+// Image button control, returns true when clicked
+func ImageButton(bounds rl.Rectangle, text string, texture rl.Texture2D) bool {
+	return defaultContext.ImageButton(bounds, text, texture)
+}
+
+// Image button control, returns true when clicked
+func ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSource rl.Rectangle) bool {
+	return defaultContext.ImageButtonEx(bounds, text, texture, texSource)
+}
+
+// Toggle Button control, returns true when active
+func Toggle(bounds rl.Rectangle, text string, active bool) bool {
+	return defaultContext.Toggle(bounds, text, active)
+}
+
+// Toggle Group control, returns toggled button index
+func ToggleGroup(bounds rl.Rectangle, text string, active int) int {
+	return defaultContext.ToggleGroup(bounds, text, active)
+}
+
+// Check Box control, returns true when active
+func CheckBox(bounds rl.Rectangle, text string, checked bool) bool {
+	return defaultContext.CheckBox(bounds, text, checked)
+}
+
+// Combo Box control, returns selected item index
+func ComboBox(bounds rl.Rectangle, text string, active int) int {
+	return defaultContext.ComboBox(bounds, text, active)
+}
+
+// Dropdown Box control
+func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) bool {
+	return defaultContext.DropdownBox(bounds, text, active, editMode)
+}
+
+// Text Box control, updates input text
+func TextBox(bounds rl.Rectangle, text string, textSize int, editMode bool) (string, bool) {
+	return defaultContext.TextBox(bounds, text, textSize, editMode)
+}
+
+// Text Box control with input options: filter, character limit, password mask and placeholder
+func TextBoxPro(bounds rl.Rectangle, text string, options TextBoxOptions, editMode bool) (string, bool) {
+	return defaultContext.TextBoxPro(bounds, text, options, editMode)
+}
+
+// Spinner control, returns selected value
+func Spinner(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	return defaultContext.Spinner(bounds, text, value, minValue, maxValue, editMode)
+}
+
+// Value Box control, updates input text with numbers
+func ValueBox(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	return defaultContext.ValueBox(bounds, text, value, minValue, maxValue, editMode)
+}
+
+// Float Box control, updates input text with decimal numbers
+func FloatBox(bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32, editMode bool) bool {
+	return defaultContext.FloatBox(bounds, text, value, minValue, maxValue, editMode)
+}
+
+// Drag Float control, changes value dragging the mouse horizontally
+func DragFloat(bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32, editMode bool) bool {
+	return defaultContext.DragFloat(bounds, text, value, minValue, maxValue, editMode)
+}
+
+// Text Box control with multiple lines
+func TextBoxMulti(bounds rl.Rectangle, text string, textSize int, scroll *rl.Vector2, cursor *int, editMode bool) (string, bool) {
+	return defaultContext.TextBoxMulti(bounds, text, textSize, scroll, cursor, editMode)
+}
+
+// Slider control with pro parameters
+func SliderPro(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32, sliderWidth int) float32 {
+	return defaultContext.SliderPro(bounds, textLeft, textRight, value, minValue, maxValue, sliderWidth)
+}
+
+// Slider control extended, returns selected value and has text
+func Slider(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	return defaultContext.Slider(bounds, textLeft, textRight, value, minValue, maxValue)
+}
+
+// Slider Bar control extended, returns selected value
+func SliderBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	return defaultContext.SliderBar(bounds, textLeft, textRight, value, minValue, maxValue)
+}
+
+// Progress Bar control extended, shows current progress value
+func ProgressBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	return defaultContext.ProgressBar(bounds, textLeft, textRight, value, minValue, maxValue)
+}
+
+// Progress Bar control for work of unknown length, draws a segment
+func ProgressBarIndeterminate(bounds rl.Rectangle, textLeft, textRight string) {
+	defaultContext.ProgressBarIndeterminate(bounds, textLeft, textRight)
+}
+
+// Status Bar control
+func StatusBar(bounds rl.Rectangle, text string) {
+	defaultContext.StatusBar(bounds, text)
+}
+
+// Scroll Bar control
+func ScrollBar(bounds rl.Rectangle, value, minValue, maxValue int) int {
+	return defaultContext.ScrollBar(bounds, value, minValue, maxValue)
+}
+
+// List View control, returns selected list item index
+func ListView(bounds rl.Rectangle, text string, scrollIndex *int, active int) int {
+	return defaultContext.ListView(bounds, text, scrollIndex, active)
+}
+
+// List View control with extended parameters
+func ListViewEx(bounds rl.Rectangle, text []string, focus, scrollIndex *int, active int) int {
+	return defaultContext.ListViewEx(bounds, text, focus, scrollIndex, active)
+}
+
+// Color Panel control
+func ColorPanelEx(bounds rl.Rectangle, color rl.Color, hue float32) rl.Color {
+	return defaultContext.ColorPanelEx(bounds, color, hue)
+}
+
+// Color Panel control, hue is taken from the color
+func ColorPanel(bounds rl.Rectangle, color rl.Color) rl.Color {
+	return defaultContext.ColorPanel(bounds, color)
+}
+
+// Color Bar Alpha control
+func ColorBarAlpha(bounds rl.Rectangle, alpha float32) float32 {
+	return defaultContext.ColorBarAlpha(bounds, alpha)
+}
+
+// Color Bar Hue control
+func ColorBarHue(bounds rl.Rectangle, hue float32) float32 {
+	return defaultContext.ColorBarHue(bounds, hue)
+}
+
+// Color Picker control
+func ColorPicker(bounds rl.Rectangle, color rl.Color) rl.Color {
+	return defaultContext.ColorPicker(bounds, color)
+}
+
+// Message Box control, displays a message
+func MessageBox(bounds rl.Rectangle, title, message, buttons string) int {
+	return defaultContext.MessageBox(bounds, title, message, buttons)
+}
+
+// Text Input Box control, ask for text
+func TextInputBox(bounds rl.Rectangle, title, message, buttons, text string) (string, int) {
+	return defaultContext.TextInputBox(bounds, title, message, buttons, text)
+}
+
+// Grid control
+func Grid(bounds rl.Rectangle, spacing float32, subdivs int) rl.Vector2 {
+	return defaultContext.Grid(bounds, spacing, subdivs)
+}
+
+// Load raygui style file (.rgs) over global style
+func LoadStyle(fileName string) error {
+	return defaultContext.LoadStyle(fileName)
+}
+
+// Load raygui style (.rgs) from reader over global style, text and binary formats are supported
+func LoadStyleFromReader(r io.Reader) error {
+	return defaultContext.LoadStyleFromReader(r)
+}
+
+// Save global style to writer as raygui style (.rgs), only properties different from default style are saved
+func SaveStyle(w io.Writer, format StyleFormat) error {
+	return defaultContext.SaveStyle(w, format)
+}
+
+// Load style default over global style
+func LoadStyleDefault() {
+	defaultContext.LoadStyleDefault()
+}
+
+// Load raygui icons file (.rgi) over global icons, returns the icons name ids
+func LoadIcons(fileName string) ([]string, error) {
+	return defaultContext.LoadIcons(fileName)
+}
+
+// Load raygui icons (.rgi) from reader over global icons, returns the icons name ids
+func LoadIconsFromReader(r io.Reader) ([]string, error) {
+	return defaultContext.LoadIconsFromReader(r)
+}
+
+// Draw selected icon using rectangles pixel-by-pixel
+func DrawIcon(iconId int, position rl.Vector2, pixelSize int, color rl.Color) {
+	defaultContext.DrawIcon(iconId, position, pixelSize, color)
+}
+
+// Get icon bit data
+func GetIconData(iconId int) [RIconDataElements]uint32 {
+	return defaultContext.GetIconData(iconId)
+}
+
+// Set icon bit data
+func SetIconData(iconId int, data [RIconDataElements]uint32) {
+	defaultContext.SetIconData(iconId, data)
+}
+
+// Set icon pixel value
+func SetIconPixel(iconId, x, y int) {
+	defaultContext.SetIconPixel(iconId, x, y)
+}
+
+// Clear icon pixel value
+func ClearIconPixel(iconId, x, y int) {
+	defaultContext.ClearIconPixel(iconId, x, y)
+}
+
+// Check icon pixel value
+func CheckIconPixel(iconId, x, y int) bool {
+	return defaultContext.CheckIconPixel(iconId, x, y)
+}
+
+// Gui get text width using default font
+func GetTextWidth(text string) int {
+	return defaultContext.GetTextWidth(text)
+}
+
+// Get text bounds considering control bounds
+func GetTextBounds(control Control, bounds rl.Rectangle) rl.Rectangle {
+	return defaultContext.GetTextBounds(control, bounds)
+}
+
+// Gui draw text using default font
+func DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl.Color) {
+	defaultContext.DrawText(text, bounds, alignment, tint)
+}
+
+// Gui draw rectangle using default raygui plain style with borders
+func DrawRectangle(rec rl.Rectangle, borderWidth int, borderColor, color rl.Color) {
+	defaultContext.DrawRectangle(rec, borderWidth, borderColor, color)
+}
+
+// Split controls text into multiple strings
+func TextSplit(text string, count *int, textRow []int) []string {
+	return defaultContext.TextSplit(text, count, textRow)
+}
+
+// Forget undo history of all controls
+func ClearUndo() {
+	defaultContext.ClearUndo()
+}
+
+// Set undo history handler, nil keeps edits only in control undo stacks
+func SetUndoHandler(handler UndoHandler) {
+	defaultContext.SetUndoHandler(handler)
+}
+
+// Set gui input and drawing backend, nil restores the raylib backend
+func SetBackend(backend Backend) {
+	defaultContext.SetBackend(backend)
+}
+
+// Get gui input and drawing backend
+func GetBackend() Backend {
+	return defaultContext.GetBackend()
+}
+
+// Get control under the mouse
+func HotID() ID {
+	return defaultContext.HotID()
+}
+
+// Get control being interacted with, while mouse button is held down
+func ActiveID() ID {
+	return defaultContext.ActiveID()
+}
+
+// Get control in edit mode, 0 if none
+func FocusedID() ID {
+	return defaultContext.FocusedID()
+}
+
+// Set control in edit mode, 0 ends edit mode of focused control
+func SetFocusedID(id ID) {
+	defaultContext.SetFocusedID(id)
+}
+
+// Forget retained state of all ID-based controls
+func ResetIDs() {
+	defaultContext.ResetIDs()
+}
+
+// Text Box control with retained edit mode, returns true when editing ends
+func TextBoxID(id ID, bounds rl.Rectangle, text string, textSize int) (string, bool) {
+	return defaultContext.TextBoxID(id, bounds, text, textSize)
+}
+
+// Text Box control with input options and retained edit mode, returns true when editing ends
+func TextBoxProID(id ID, bounds rl.Rectangle, text string, options TextBoxOptions) (string, bool) {
+	return defaultContext.TextBoxProID(id, bounds, text, options)
+}
+
+// Text Box control with multiple lines and retained edit mode, scroll and cursor
+// position, returns true when editing ends
+func TextBoxMultiID(id ID, bounds rl.Rectangle, text string, textSize int) (string, bool) {
+	return defaultContext.TextBoxMultiID(id, bounds, text, textSize)
+}
+
+// Value Box control with retained edit mode, returns true when editing ends
+func ValueBoxID(id ID, bounds rl.Rectangle, text string, value *int, minValue, maxValue int) bool {
+	return defaultContext.ValueBoxID(id, bounds, text, value, minValue, maxValue)
+}
+
+// Spinner control with retained edit mode, returns true when editing ends
+func SpinnerID(id ID, bounds rl.Rectangle, text string, value *int, minValue, maxValue int) bool {
+	return defaultContext.SpinnerID(id, bounds, text, value, minValue, maxValue)
+}
+
+// Float Box control with retained edit mode, returns true when editing ends
+func FloatBoxID(id ID, bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32) bool {
+	return defaultContext.FloatBoxID(id, bounds, text, value, minValue, maxValue)
+}
+
+// Drag Float control with retained edit mode, returns true when editing ends
+func DragFloatID(id ID, bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32) bool {
+	return defaultContext.DragFloatID(id, bounds, text, value, minValue, maxValue)
+}
+
+// Dropdown Box control with retained open state, returns true when active item changes
+func DropdownBoxID(id ID, bounds rl.Rectangle, text string, active *int) bool {
+	return defaultContext.DropdownBoxID(id, bounds, text, active)
+}
+
+// List View control with retained scroll index, returns selected list item index
+func ListViewID(id ID, bounds rl.Rectangle, text string, active int) int {
+	return defaultContext.ListViewID(id, bounds, text, active)
+}
+
+// Scroll Panel control with retained scroll, returns view area and scroll
+func ScrollPanelID(id ID, bounds, content rl.Rectangle) (rl.Rectangle, rl.Vector2) {
+	return defaultContext.ScrollPanelID(id, bounds, content)
+}
+
+// Clear keyboard focus
+func ClearFocus() {
+	defaultContext.ClearFocus()
+}
@@ -0,0 +1,85 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestContextIndependentState(t *testing.T) {
+	hudBackend, editorBackend := NewHeadlessBackend(), NewHeadlessBackend()
+	hud, editor := newTestContext(hudBackend), newTestContext(editorBackend)
+
+	editor.SetStyle(ButtonControl, BaseColorNormalProp, 0x112233ff)
+	editor.Lock()
+	editor.Fade(0.5)
+
+	bounds := rl.Rectangle{X: 10, Y: 10, Width: 80, Height: 30}
+	inside := rl.Vector2{X: 50, Y: 25}
+
+	for _, b := range []*HeadlessBackend{hudBackend, editorBackend} {
+		b.NextFrame()
+		b.MousePosition = inside
+		b.SetMouseButton(rl.MouseLeftButton, true)
+	}
+	hud.Button(bounds, "HUD")
+	editor.Button(bounds, "Editor")
+
+	for _, b := range []*HeadlessBackend{hudBackend, editorBackend} {
+		b.NextFrame()
+		b.MousePosition = inside
+		b.SetMouseButton(rl.MouseLeftButton, false)
+	}
+	if !hud.Button(bounds, "HUD") {
+		t.Error("hud button not pressed")
+	}
+	if editor.Button(bounds, "Editor") {
+		t.Error("locked editor button pressed")
+	}
+
+	if got, want := hudBackend.DrawCalls[0].Colors[0], rl.GetColor(int32(hud.GetStyle(ButtonControl, BaseColorFocusedProp))); got != want {
+		t.Errorf("hud base color = %v, want %v", got, want)
+	}
+	if got, want := editorBackend.DrawCalls[0].Colors[0], rl.Fade(rl.GetColor(0x112233ff), 0.5); got != want {
+		t.Errorf("editor base color = %v, want %v", got, want)
+	}
+	if hud.GetStyle(ButtonControl, BaseColorNormalProp) == 0x112233ff {
+		t.Error("editor style changed hud style")
+	}
+}
+
+func TestContextParallel(t *testing.T) {
+	for _, text := range []string{"One", "Two", "Three", "Four"} {
+		text := text
+		t.Run(text, func(t *testing.T) {
+			t.Parallel()
+
+			b := NewHeadlessBackend()
+			ctx := newTestContext(b)
+			active := 0
+			for frame := 0; frame < 100; frame++ {
+				b.NextFrame()
+				b.MousePosition = rl.Vector2{X: float32(frame), Y: 15}
+				b.SetMouseButton(rl.MouseLeftButton, frame%2 == 0)
+
+				ctx.Button(rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 30}, text)
+				active = ctx.ToggleGroup(rl.Rectangle{X: 0, Y: 40, Width: 30, Height: 30}, "A;B;C", active)
+				if !containsText(b.Texts(), text) {
+					t.Fatalf("frame %d: text %q not drawn", frame, text)
+				}
+			}
+		})
+	}
+}
+
+func TestDefaultContext(t *testing.T) {
+	b := newTestBackend(t)
+
+	SetState(StateDisabled)
+	if got := DefaultContext().GetState(); got != StateDisabled {
+		t.Errorf("default context state = %v, want disabled", got)
+	}
+	if GetBackend() != Backend(b) {
+		t.Error("default context backend not set by SetBackend()")
+	}
+}
@@ -0,0 +1,274 @@
+package raygui
+
+import (
+	"math"
+	"strconv"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Maximum time in seconds between the clicks of a double-click -- DragFloat()
+const DoubleClickTime = 0.4
+
+// Text of the float value control in edit mode -- FloatBox(), DragFloat()
+// NOTE: Typed text is kept between frames, since text like "1." or "-" can't
+// be rebuilt from the value every frame as ValueBox() does
+type floatEdit struct {
+	bounds rl.Rectangle // Bounds of the control being edited
+	active bool         // Text belongs to a control in edit mode
+	text   string       // Text typed so far
+}
+
+// Drag state of the drag float control dragged or clicked last -- DragFloat()
+type floatDrag struct {
+	bounds    rl.Rectangle // Bounds of the control
+	dragging  bool         // Mouse button pressed on the control and held down
+	moved     bool         // Mouse moved while dragging, release is not a click
+	mouseX    float32      // Mouse position when value was last changed
+	value     float32      // Value dragged to, before rounding to the step
+	clicked   bool         // Control was clicked without dragging
+	clickTime float32      // Time of the last click, to detect a double-click
+}
+
+// Float Box control, updates input text with decimal numbers
+// NOTE: Value is shown with FloatPrecision decimals of the ValueBox style and
+// edited as text with a text box accepting decimal numbers (see TextFilterFloat())
+func (ctx *Context) FloatBox(bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32, editMode bool) bool {
+	state := ctx.state
+	pressed := false
+
+	if editMode {
+		if state != StateDisabled && !ctx.locked {
+			state = StatePressed
+		}
+		pressed = ctx.floatBoxEdit(bounds, value, minValue, maxValue)
+	} else {
+		ctx.floatEditEnd(bounds)
+
+		// Update control
+		//--------------------------------------------------------------------
+		if state != StateDisabled && !ctx.locked {
+			*value = clampFloat(*value, minValue, maxValue)
+
+			if rl.CheckCollisionPointRec(ctx.backend.GetMousePosition(), bounds) {
+				state = StateFocused
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+					pressed = true
+				}
+			}
+		}
+		//--------------------------------------------------------------------
+
+		ctx.floatBoxDraw(bounds, *value, state)
+	}
+
+	ctx.floatBoxLabel(bounds, text, state)
+
+	return pressed
+}
+
+// Drag Float control, changes value dragging the mouse horizontally
+// NOTE: Every pixel dragged changes value by DragSpeed steps of the last decimal
+// shown (see FloatPrecision), holding Shift makes steps ten times smaller.
+// Ctrl+Z and Ctrl+Y undo and redo drags while the mouse is over the control.
+// Double-click returns true to start edit mode, where value is typed as in FloatBox()
+func (ctx *Context) DragFloat(bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32, editMode bool) bool {
+	state := ctx.state
+	pressed := false
+
+	if editMode {
+		if state != StateDisabled && !ctx.locked {
+			state = StatePressed
+		}
+		pressed = ctx.floatBoxEdit(bounds, value, minValue, maxValue)
+	} else {
+		ctx.floatEditEnd(bounds)
+
+		// Update control
+		//--------------------------------------------------------------------
+		if state != StateDisabled && !ctx.locked {
+			mousePoint := ctx.backend.GetMousePosition()
+			d := &ctx.floatDrag
+
+			*value = clampFloat(*value, minValue, maxValue)
+
+			if rl.CheckCollisionPointRec(mousePoint, bounds) {
+				state = StateFocused
+
+				// Undo and redo drags while the mouse is over the control
+				if undone, ok := ctx.undoKeys(ValueBoxControl, bounds, UndoState{Value: float64(*value)}); ok {
+					*value = clampFloat(float32(undone.Value), minValue, maxValue)
+					if d.bounds == bounds {
+						d.value = *value
+					}
+				}
+
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+					if d.bounds != bounds {
+						*d = floatDrag{bounds: bounds}
+					}
+					d.dragging = true
+					d.moved = false
+					d.mouseX = mousePoint.X
+					d.value = *value
+
+					// Every drag is a separate undo step
+					ctx.undoBreak(ValueBoxControl, bounds)
+				}
+			}
+
+			if d.bounds == bounds && d.dragging {
+				if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+					state = StatePressed
+
+					if dx := mousePoint.X - d.mouseX; dx != 0 {
+						step := ctx.dragFloatStep()
+						d.value = clampFloat(d.value+dx*float32(step), minValue, maxValue)
+						d.mouseX = mousePoint.X
+						d.moved = true
+
+						// Value is rounded to the step, dragging less than a step accumulates
+						dragged := clampFloat(float32(math.Round(float64(d.value)/step)*step), minValue, maxValue)
+						if dragged != *value {
+							ctx.undoRecord(ValueBoxControl, bounds, UndoState{Value: float64(*value)}, UndoState{Value: float64(dragged)}, true)
+							*value = dragged
+						}
+					}
+				} else {
+					d.dragging = false
+
+					// Release without dragging is a click, a second click soon after starts edit mode
+					if d.moved {
+						d.clicked = false
+					} else if d.clicked && ctx.backend.GetTime()-d.clickTime <= DoubleClickTime {
+						d.clicked = false
+						pressed = true
+					} else {
+						d.clicked = true
+						d.clickTime = ctx.backend.GetTime()
+					}
+				}
+			}
+		}
+		//--------------------------------------------------------------------
+
+		ctx.floatBoxDraw(bounds, *value, state)
+	}
+
+	ctx.floatBoxLabel(bounds, text, state)
+
+	return pressed
+}
+
+// Get value change per pixel dragged, ten times smaller while Shift is held down
+func (ctx *Context) dragFloatStep() float64 {
+	step := float64(ctx.GetStyle(ValueBoxControl, DragSpeed)) * math.Pow10(-int(ctx.GetStyle(ValueBoxControl, FloatPrecision)))
+	if ctx.backend.IsKeyDown(rl.KeyLeftShift) || ctx.backend.IsKeyDown(rl.KeyRightShift) {
+		step /= 10
+	}
+	return step
+}
+
+// Edit float value as text with a text box, returns true when editing ends
+// NOTE: Value changes once the typed text is a number, and is clamped when editing ends
+func (ctx *Context) floatBoxEdit(bounds rl.Rectangle, value *float32, minValue, maxValue float32) bool {
+	e := &ctx.floatEdit
+	if !e.active || e.bounds != bounds {
+		*e = floatEdit{bounds: bounds, active: true, text: ctx.floatText(*value)}
+
+		// Value text is selected, so typing replaces it
+		ctx.textEdit = textEdit{bounds: bounds, active: true, cursor: len(e.text), original: e.text}
+	}
+
+	// Undo and redo value edits, typed text is replaced by the restored value
+	if ctx.state != StateDisabled && !ctx.locked {
+		if undone, ok := ctx.undoKeys(ValueBoxControl, bounds, UndoState{Value: float64(*value)}); ok {
+			*value = float32(undone.Value)
+			e.text = ctx.floatText(*value)
+		}
+	}
+
+	// NOTE: TEXT_ALIGNMENT forced to the centered text of ValueBox,
+	// text edits are recorded for undo as value edits
+	tempTextAlign := ctx.GetStyle(TextBoxControl, TextAlignmentProp)
+	ctx.SetStyle(TextBoxControl, TextAlignmentProp, uint(TextAlignCenter))
+	ctx.undoDisabled = true
+
+	text, pressed := ctx.TextBoxPro(bounds, e.text, TextBoxOptions{Filter: TextFilterFloat, MaxRunes: ValueBoxMaxChars}, true)
+
+	ctx.undoDisabled = false
+	ctx.SetStyle(TextBoxControl, TextAlignmentProp, tempTextAlign)
+
+	if text != e.text {
+		e.text = text
+		if typed, err := strconv.ParseFloat(text, 32); err == nil && float32(typed) != *value {
+			ctx.undoRecord(ValueBoxControl, bounds, UndoState{Value: float64(*value)}, UndoState{Value: typed}, true)
+			*value = float32(typed)
+		}
+	}
+
+	if pressed {
+		e.active = false
+		*value = clampFloat(*value, minValue, maxValue)
+	}
+
+	return pressed
+}
+
+// End edit mode text of float value control, next edit mode starts from its value
+func (ctx *Context) floatEditEnd(bounds rl.Rectangle) {
+	if ctx.floatEdit.bounds == bounds {
+		ctx.floatEdit.active = false
+	}
+}
+
+// Get float value text with FloatPrecision decimals
+func (ctx *Context) floatText(value float32) string {
+	return strconv.FormatFloat(float64(value), 'f', int(ctx.GetStyle(ValueBoxControl, FloatPrecision)), 32)
+}
+
+// Draw float value control not in edit mode, same as ValueBox()
+func (ctx *Context) floatBoxDraw(bounds rl.Rectangle, value float32, state ControlState) {
+	baseColor := rl.Blank
+	if state == StatePressed {
+		baseColor = rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, BaseColorPressedProp)))
+	} else if state == StateDisabled {
+		baseColor = rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, BaseColorDisabledProp)))
+	}
+
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ValueBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), baseColor)
+	ctx.DrawText(ctx.floatText(value), ctx.GetTextBounds(ValueBoxControl, bounds), TextAlignCenter, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+}
+
+// Draw float value control label if provided, same as ValueBox()
+func (ctx *Context) floatBoxLabel(bounds rl.Rectangle, text string, state ControlState) {
+	if text == "" {
+		return
+	}
+
+	textBounds := rl.Rectangle{
+		X:      bounds.X + bounds.Width + float32(ctx.GetStyle(ValueBoxControl, TextPaddingProp)),
+		Y:      bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2),
+		Width:  float32(ctx.GetTextWidth(text)),
+		Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+	}
+
+	align := TextAlignRight
+	if TextAlignment(ctx.GetStyle(ValueBoxControl, TextAlignmentProp)) == TextAlignLeft {
+		textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(ValueBoxControl, TextPaddingProp))
+	} else if TextAlignment(ctx.GetStyle(ValueBoxControl, TextAlignmentProp)) == TextAlignRight {
+		align = TextAlignLeft
+	}
+	ctx.DrawText(text, textBounds, align, rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+}
+
+// Clamp value to range
+func clampFloat(value, minValue, maxValue float32) float32 {
+	if value < minValue {
+		return minValue
+	}
+	if value > maxValue {
+		return maxValue
+	}
+	return value
+}
@@ -0,0 +1,168 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestFloatBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+
+	t.Run("typing", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(1.25)
+		for _, r := range "3x.5" {
+			b.NextFrame()
+			b.TypeText(string(r))
+			FloatBox(bounds, "", &value, 0, 10, true)
+		}
+		if value != 3.5 {
+			t.Fatalf("value = %v, want 3.5", value)
+		}
+
+		pressKeys(b, rl.KeyEnter)
+		if !FloatBox(bounds, "", &value, 0, 10, true) {
+			t.Error("enter did not end edit mode")
+		}
+	})
+
+	t.Run("clamped when editing ends", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(1)
+		for _, r := range "50" {
+			b.NextFrame()
+			b.TypeText(string(r))
+			FloatBox(bounds, "", &value, 0, 10, true)
+		}
+		pressKeys(b, rl.KeyEnter)
+		FloatBox(bounds, "", &value, 0, 10, true)
+		if value != 10 {
+			t.Errorf("value = %v, want 10", value)
+		}
+	})
+
+	t.Run("precision", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		SetStyle(ValueBoxControl, FloatPrecision, 3)
+		value := float32(1.25)
+		b.NextFrame()
+		FloatBox(bounds, "Mass", &value, 0, 10, false)
+		if texts := b.Texts(); len(texts) != 2 || texts[0] != "1.250" || texts[1] != "Mass" {
+			t.Errorf("texts = %q, want value with 3 decimals and label", texts)
+		}
+	})
+}
+
+func TestDragFloat(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+	start := rl.Vector2{X: 50, Y: 10}
+
+	// Drag from start by dx pixels and release
+	drag := func(b *HeadlessBackend, value *float32, dx float32, keys ...int32) {
+		pressKeys(b, keys...)
+		mouseFrame(b, start, true)
+		DragFloat(bounds, "", value, -10, 10, false)
+		mouseFrame(b, rl.Vector2{X: start.X + dx, Y: start.Y}, true)
+		DragFloat(bounds, "", value, -10, 10, false)
+		mouseFrame(b, rl.Vector2{X: start.X + dx, Y: start.Y}, false)
+		DragFloat(bounds, "", value, -10, 10, false)
+	}
+
+	t.Run("drag", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(1)
+		drag(b, &value, 10)
+		if value != 1.1 {
+			t.Errorf("value = %v, want 1.1", value)
+		}
+
+		drag(b, &value, -25, rl.KeyLeftShift)
+		if value != 1.075 {
+			t.Errorf("value = %v after fine drag, want 1.075", value)
+		}
+
+		drag(b, &value, 2000)
+		if value != 10 {
+			t.Errorf("value = %v, want clamped to 10", value)
+		}
+	})
+
+	t.Run("double-click edits", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value, editMode := float32(2), false
+		frame := func() {
+			if DragFloat(bounds, "", &value, -10, 10, editMode) {
+				editMode = !editMode
+			}
+		}
+
+		click(b, start, frame)
+		if editMode {
+			t.Fatal("single click started edit mode")
+		}
+		click(b, start, frame)
+		if !editMode {
+			t.Fatal("double-click did not start edit mode")
+		}
+
+		b.NextFrame()
+		b.TypeText("-")
+		frame()
+		b.NextFrame()
+		b.TypeText("4")
+		frame()
+		if value != -4 || !editMode {
+			t.Errorf("value, editMode = %v, %v, want -4 while editing", value, editMode)
+		}
+	})
+
+	t.Run("undo drag", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(0)
+		drag(b, &value, 30)
+		drag(b, &value, 30)
+
+		pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+		DragFloat(bounds, "", &value, -10, 10, true)
+		if value != 0.3 {
+			t.Errorf("value = %v after undo, want 0.3", value)
+		}
+	})
+
+	t.Run("undo drag while hovered", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(0)
+		drag(b, &value, 30)
+		drag(b, &value, 30)
+
+		for i, step := range []struct {
+			keys []int32
+			want float32
+		}{
+			{[]int32{rl.KeyLeftControl, rl.KeyZ}, 0.3},
+			{[]int32{rl.KeyLeftControl, rl.KeyZ}, 0},
+			{[]int32{rl.KeyLeftControl, rl.KeyZ}, 0},
+			{[]int32{rl.KeyLeftControl, rl.KeyY}, 0.3},
+		} {
+			pressKeys(b, step.keys...)
+			if DragFloat(bounds, "", &value, -10, 10, false); value != step.want {
+				t.Fatalf("step %d: value = %v, want %v", i, value, step.want)
+			}
+		}
+
+		// Keys are ignored while the mouse is away from the control
+		mouseFrame(b, rl.Vector2{X: 300, Y: 10}, false)
+		pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+		if DragFloat(bounds, "", &value, -10, 10, false); value != 0.3 {
+			t.Errorf("value = %v after undo away from control, want 0.3", value)
+		}
+	})
+}
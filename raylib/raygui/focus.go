@@ -0,0 +1,131 @@
+package raygui
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Keyboard focus
+// NOTE: Tab and Shift+Tab move focus across Button, Toggle, CheckBox, ComboBox,
+// DropdownBox, TextBox, Spinner and ScrollBar in declaration order, Space and Enter
+// activate the focused control and arrow keys change its value. Controls have no
+// identifier, so they are identified by type and bounds: focus moves along the
+// controls drawn in previous frame, frames are delimited by BeginFrame() and there
+// is no focus if it is never called (see package documentation). Nothing is focused
+// until Tab or the gamepad d-pad (see gamepad.go) is pressed, pressing the mouse
+// clears keyboard focus.
+
+// Focusable control identifier
+type focusKey struct {
+	control Control
+	bounds  rl.Rectangle
+}
+
+// Register focusable control drawn in current frame, returns true if it has keyboard focus
+func (ctx *Context) focusControl(control Control, bounds rl.Rectangle) bool {
+	if ctx.focusDisabled || ctx.frame == 0 {
+		return false
+	}
+
+	key := focusKey{control, bounds}
+	ctx.focusFrame = append(ctx.focusFrame, key)
+
+	return ctx.focus == key
+}
+
+// Set keyboard focus to control, used by controls in edit mode
+func (ctx *Context) setFocus(control Control, bounds rl.Rectangle) {
+	if !ctx.focusDisabled {
+		ctx.focus = focusKey{control, bounds}
+		ctx.focusEditing = true
+	}
+}
+
+// Start a new focus frame, moving keyboard focus with current frame input -- BeginFrame()
+func (ctx *Context) beginFocusFrame() {
+	ctx.focusPrev, ctx.focusFrame = ctx.focusFrame, ctx.focusPrev[:0]
+
+	editing := ctx.focusEditing
+	ctx.focusEditing = false
+
+	if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+		ctx.focus = focusKey{}
+	}
+
+	if ctx.backend.IsKeyPressed(rl.KeyTab) {
+		ctx.moveFocus(ctx.backend.IsKeyDown(rl.KeyLeftShift) || ctx.backend.IsKeyDown(rl.KeyRightShift))
+	} else if !editing {
+		ctx.gamepadMoveFocus()
+	}
+}
+
+// Move keyboard focus to next (or previous) control drawn in previous frame, wrapping around
+func (ctx *Context) moveFocus(backward bool) {
+	count := len(ctx.focusPrev)
+	if count == 0 {
+		return
+	}
+
+	index := -1
+	for i, k := range ctx.focusPrev {
+		if k == ctx.focus {
+			index = i
+			break
+		}
+	}
+
+	if index < 0 {
+		if backward {
+			index = count - 1
+		} else {
+			index = 0
+		}
+	} else if backward {
+		index = (index + count - 1) % count
+	} else {
+		index = (index + 1) % count
+	}
+
+	ctx.focus = ctx.focusPrev[index]
+}
+
+// Check if focused control has been activated with Space, Enter or gamepad A
+func (ctx *Context) focusActivated() bool {
+	return ctx.backend.IsKeyPressed(rl.KeySpace) || ctx.backend.IsKeyPressed(rl.KeyEnter) || ctx.gamepadPressed(gamepadButtonRightFaceDown)
+}
+
+// Check if control edit mode has been cancelled with gamepad B
+func (ctx *Context) focusCancelled() bool {
+	return ctx.gamepadPressed(gamepadButtonRightFaceRight)
+}
+
+// Get focused control value change requested with arrow keys: -1, 0 or 1
+func (ctx *Context) focusStep() int {
+	if ctx.backend.IsKeyPressed(rl.KeyLeft) || ctx.backend.IsKeyPressed(rl.KeyUp) {
+		return -1
+	}
+	if ctx.backend.IsKeyPressed(rl.KeyRight) || ctx.backend.IsKeyPressed(rl.KeyDown) {
+		return 1
+	}
+	return 0
+}
+
+// Check if keyboard focus moves away from control in edit mode
+func (ctx *Context) focusTabbed() bool {
+	return ctx.backend.IsKeyPressed(rl.KeyTab)
+}
+
+// Clear keyboard focus
+func (ctx *Context) ClearFocus() {
+	ctx.focus = focusKey{}
+}
+
+// Clamp focused control item index to [0..count-1]
+func focusClamp(index, count int) int {
+	if index < 0 {
+		return 0
+	}
+	if index > count-1 {
+		return count - 1
+	}
+	return index
+}
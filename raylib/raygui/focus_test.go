@@ -0,0 +1,324 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestFocusTraversal(t *testing.T) {
+	b := newTestBackend(t)
+
+	var pressed, checked, active bool
+	frame := func() {
+		pressed = Button(rl.Rectangle{X: 0, Y: 0, Width: 80, Height: 20}, "Button")
+		checked = CheckBox(rl.Rectangle{X: 0, Y: 30, Width: 15, Height: 15}, "Check", checked)
+		active = Toggle(rl.Rectangle{X: 0, Y: 60, Width: 80, Height: 20}, "Toggle", active)
+	}
+
+	// Nothing is focused until Tab is pressed
+	mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+	frame()
+	pressKeys(b, rl.KeySpace)
+	frame()
+	if pressed || checked || active {
+		t.Fatalf("pressed, checked, active = %v, %v, %v without focus", pressed, checked, active)
+	}
+
+	// Tab focuses first control
+	pressKeys(b, rl.KeyTab)
+	frame()
+	if got, want := b.DrawCallsOf(DrawRectangleCall)[0].Colors[0], styleColor(ButtonControl, BaseColorFocusedProp); got != want {
+		t.Errorf("focused button base color = %v, want %v", got, want)
+	}
+	pressKeys(b, rl.KeyEnter)
+	frame()
+	if !pressed {
+		t.Error("focused button not pressed by enter")
+	}
+
+	// Tab moves to next control in declaration order
+	pressKeys(b, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeySpace)
+	frame()
+	if !checked || pressed || active {
+		t.Errorf("pressed, checked, active = %v, %v, %v, want check box toggled", pressed, checked, active)
+	}
+
+	// Tab wraps around after last control
+	pressKeys(b, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeySpace)
+	frame()
+	if !pressed || active {
+		t.Errorf("pressed, active = %v, %v, want button pressed after wrapping", pressed, active)
+	}
+
+	// Shift+Tab moves backwards, wrapping to last control
+	pressKeys(b, rl.KeyLeftShift, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeySpace)
+	frame()
+	if !active || pressed {
+		t.Errorf("pressed, active = %v, %v, want toggle activated", pressed, active)
+	}
+
+	// Pressing the mouse clears keyboard focus
+	mouseFrame(b, rl.Vector2{X: 300, Y: 300}, true)
+	frame()
+	pressKeys(b, rl.KeySpace)
+	frame()
+	if !active {
+		t.Error("toggle activated after mouse press cleared focus")
+	}
+}
+
+func TestFocusSkipsDisabled(t *testing.T) {
+	b := newTestBackend(t)
+
+	var first, second bool
+	frame := func() {
+		first = Button(rl.Rectangle{X: 0, Y: 0, Width: 80, Height: 20}, "First")
+		Disable()
+		Button(rl.Rectangle{X: 0, Y: 30, Width: 80, Height: 20}, "Disabled")
+		Enable()
+		second = Button(rl.Rectangle{X: 0, Y: 60, Width: 80, Height: 20}, "Second")
+	}
+
+	mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+	frame()
+	pressKeys(b, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeyTab)
+	frame()
+	pressKeys(b, rl.KeyEnter)
+	frame()
+	if first || !second {
+		t.Errorf("first, second = %v, %v, want second button pressed", first, second)
+	}
+}
+
+func TestFocusValues(t *testing.T) {
+	t.Run("combo box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 0
+		frame := func() { active = ComboBox(rl.Rectangle{X: 0, Y: 0, Width: 120, Height: 20}, "A;B;C", active) }
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		pressKeys(b, rl.KeyTab)
+		frame()
+		for i, step := range []struct {
+			key  int32
+			want int
+		}{{rl.KeyRight, 1}, {rl.KeySpace, 2}, {rl.KeyDown, 0}, {rl.KeyLeft, 2}} {
+			pressKeys(b, step.key)
+			frame()
+			if active != step.want {
+				t.Fatalf("step %d: active = %d, want %d", i, active, step.want)
+			}
+		}
+
+		// Selector button is not a separate focus stop
+		pressKeys(b, rl.KeyTab)
+		frame()
+		pressKeys(b, rl.KeyRight)
+		frame()
+		if active != 0 {
+			t.Errorf("active = %d, want combo box focused again", active)
+		}
+	})
+
+	t.Run("spinner", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value, editMode := 5, false
+		frame := func() {
+			if Spinner(rl.Rectangle{X: 0, Y: 0, Width: 120, Height: 20}, "", &value, 0, 10, editMode) {
+				editMode = !editMode
+			}
+		}
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		pressKeys(b, rl.KeyTab)
+		frame()
+		for i, step := range []struct {
+			key  int32
+			want int
+		}{{rl.KeyRight, 6}, {rl.KeyLeft, 5}, {rl.KeyLeft, 4}} {
+			pressKeys(b, step.key)
+			frame()
+			if value != step.want || editMode {
+				t.Fatalf("step %d: value, editMode = %d, %v, want %d, false", i, value, editMode, step.want)
+			}
+		}
+
+		// Arrow buttons are not separate focus stops
+		pressKeys(b, rl.KeyTab)
+		frame()
+		pressKeys(b, rl.KeyRight)
+		frame()
+		if value != 5 {
+			t.Errorf("value = %d, want spinner focused again", value)
+		}
+
+		pressKeys(b, rl.KeyEnter)
+		frame()
+		if !editMode {
+			t.Fatal("enter did not start edit mode")
+		}
+		pressKeys(b, rl.KeyTab)
+		frame()
+		if editMode {
+			t.Error("tab did not end edit mode")
+		}
+	})
+
+	t.Run("dropdown box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active, editMode := 0, false
+		frame := func() {
+			if DropdownBox(rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}, "A;B;C", &active, editMode) {
+				editMode = !editMode
+			}
+		}
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		pressKeys(b, rl.KeyTab)
+		frame()
+		pressKeys(b, rl.KeyDown)
+		frame()
+		if active != 1 || editMode {
+			t.Fatalf("active, editMode = %d, %v, want 1, closed", active, editMode)
+		}
+
+		pressKeys(b, rl.KeyEnter)
+		frame()
+		if !editMode {
+			t.Fatal("enter did not open dropdown")
+		}
+
+		pressKeys(b, rl.KeyDown)
+		frame()
+		pressKeys(b, rl.KeyDown)
+		frame()
+		pressKeys(b, rl.KeyEnter)
+		frame()
+		if active != 2 || editMode {
+			t.Errorf("active, editMode = %d, %v, want 2, closed", active, editMode)
+		}
+	})
+
+	t.Run("scroll bar", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 50
+		frame := func() { value = ScrollBar(rl.Rectangle{X: 0, Y: 0, Width: 10, Height: 100}, value, 0, 100) }
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		pressKeys(b, rl.KeyTab)
+		frame()
+		pressKeys(b, rl.KeyDown)
+		frame()
+		step := 100 / int(GetStyle(ScrollBarControl, ScrollSpeed))
+		if value != 50+step {
+			t.Errorf("value = %d, want %d", value, 50+step)
+		}
+
+		pressKeys(b, rl.KeyUp)
+		frame()
+		pressKeys(b, rl.KeyUp)
+		frame()
+		if value != 50-step {
+			t.Errorf("value = %d, want %d", value, 50-step)
+		}
+	})
+
+	t.Run("text box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text, editMode := "", false
+		var pressed bool
+		frame := func() {
+			var toggle bool
+			if text, toggle = TextBox(rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 30}, text, 64, editMode); toggle {
+				editMode = !editMode
+			}
+			pressed = Button(rl.Rectangle{X: 0, Y: 40, Width: 80, Height: 20}, "OK")
+		}
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		pressKeys(b, rl.KeyTab)
+		frame()
+		pressKeys(b, rl.KeyEnter)
+		frame()
+		if !editMode {
+			t.Fatal("enter did not start edit mode")
+		}
+
+		// Space types into the text box instead of activating it
+		pressKeys(b, rl.KeySpace)
+		b.TypeText(" ")
+		frame()
+		if text != " " || !editMode {
+			t.Fatalf("text, editMode = %q, %v, want space typed", text, editMode)
+		}
+
+		// Tab ends edit mode and moves focus to next control
+		pressKeys(b, rl.KeyTab)
+		frame()
+		if editMode {
+			t.Error("tab did not end edit mode")
+		}
+		pressKeys(b, rl.KeyEnter)
+		frame()
+		if !pressed || editMode {
+			t.Errorf("pressed, editMode = %v, %v, want button pressed", pressed, editMode)
+		}
+	})
+}
+
+func TestFocusMovingControls(t *testing.T) {
+	b := newTestBackend(t)
+
+	// Controls are identified by bounds, a moving control is a new control every frame
+	x := float32(0)
+	var moving, static bool
+	frame := func(withStatic bool) {
+		x++
+		if withStatic {
+			static = Button(rl.Rectangle{X: 0, Y: 0, Width: 80, Height: 20}, "Static")
+		}
+		moving = Button(rl.Rectangle{X: x, Y: 30, Width: 80, Height: 20}, "Moving")
+		Button(rl.Rectangle{X: x, Y: 60, Width: 80, Height: 20}, "Moving too")
+	}
+
+	mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+	for i := 0; i < 100; i++ {
+		pressKeys(b)
+		frame(false)
+	}
+	if got := len(defaultContext.focusFrame); got != 2 {
+		t.Errorf("%d controls in focus frame, want the 2 drawn in last frame", got)
+	}
+
+	// Focus moves along the controls drawn in previous frame
+	pressKeys(b)
+	frame(true)
+	pressKeys(b, rl.KeyTab)
+	frame(true)
+	pressKeys(b, rl.KeyEnter)
+	frame(true)
+	if !static || moving {
+		t.Errorf("moving, static = %v, %v, want static button pressed", moving, static)
+	}
+}
@@ -0,0 +1,116 @@
+package raygui
+
+import (
+	"math"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Gamepad buttons used for navigation, same values as raylib GamepadButton
+// NOTE: raylib-go gamepad constants follow controller specific layouts of
+// an older raylib version, raylib maps every controller to this layout
+const (
+	gamepadButtonLeftFaceUp     = 1 // D-pad up
+	gamepadButtonLeftFaceRight  = 2 // D-pad right
+	gamepadButtonLeftFaceDown   = 3 // D-pad down
+	gamepadButtonLeftFaceLeft   = 4 // D-pad left
+	gamepadButtonRightFaceRight = 6 // XBOX: B, PS3: Circle
+	gamepadButtonRightFaceDown  = 7 // XBOX: A, PS3: Cross
+)
+
+// Gamepads checked for navigation, same as raylib MAX_GAMEPADS
+const maxGamepads = 4
+
+// Gamepad navigation
+// NOTE: D-pad moves keyboard focus to the nearest control in that direction,
+// A activates the focused control as Space and Enter do, B cancels DropdownBox
+// and TextBox edit modes, restoring the item or text they started with. Any
+// available gamepad can be used. While a control is in edit mode, d-pad up and
+// down change its value instead of moving focus. As keyboard focus, it requires
+// frames delimited by BeginFrame().
+
+// Check if button has been pressed on any available gamepad
+func (ctx *Context) gamepadPressed(button int32) bool {
+	for gamepad := int32(0); gamepad < maxGamepads; gamepad++ {
+		if ctx.backend.IsGamepadAvailable(gamepad) && ctx.backend.IsGamepadButtonPressed(gamepad, button) {
+			return true
+		}
+	}
+	return false
+}
+
+// Get value change requested with d-pad up and down: -1, 0 or 1
+func (ctx *Context) gamepadStep() int {
+	if ctx.gamepadPressed(gamepadButtonLeftFaceUp) {
+		return -1
+	}
+	if ctx.gamepadPressed(gamepadButtonLeftFaceDown) {
+		return 1
+	}
+	return 0
+}
+
+// Move keyboard focus with d-pad to the nearest control in pressed direction
+func (ctx *Context) gamepadMoveFocus() {
+	var direction rl.Vector2
+	switch {
+	case ctx.gamepadPressed(gamepadButtonLeftFaceUp):
+		direction.Y = -1
+	case ctx.gamepadPressed(gamepadButtonLeftFaceRight):
+		direction.X = 1
+	case ctx.gamepadPressed(gamepadButtonLeftFaceDown):
+		direction.Y = 1
+	case ctx.gamepadPressed(gamepadButtonLeftFaceLeft):
+		direction.X = -1
+	default:
+		return
+	}
+
+	if len(ctx.focusPrev) == 0 {
+		return
+	}
+
+	from := -1
+	for i, k := range ctx.focusPrev {
+		if k == ctx.focus {
+			from = i
+			break
+		}
+	}
+
+	// Nothing focused, start from first control
+	if from < 0 {
+		ctx.focus = ctx.focusPrev[0]
+		return
+	}
+
+	// Candidates are scored by distance between centers along the direction,
+	// distance across the direction counts twice to prefer aligned controls
+	center := focusCenter(ctx.focusPrev[from].bounds)
+	bestScore := float32(math.MaxFloat32)
+
+	for i, k := range ctx.focusPrev {
+		if i == from {
+			continue
+		}
+
+		c := focusCenter(k.bounds)
+		dx, dy := c.X-center.X, c.Y-center.Y
+
+		along := dx*direction.X + dy*direction.Y
+		if along <= 0 {
+			continue
+		}
+		across := float32(math.Abs(float64(dx*direction.Y))) + float32(math.Abs(float64(dy*direction.X)))
+
+		if score := along + 2*across; score < bestScore {
+			bestScore = score
+			ctx.focus = k
+		}
+	}
+}
+
+// Get center point of control bounds
+func focusCenter(bounds rl.Rectangle) rl.Vector2 {
+	return rl.Vector2{X: bounds.X + bounds.Width/2, Y: bounds.Y + bounds.Height/2}
+}
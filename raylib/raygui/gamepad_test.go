@@ -0,0 +1,169 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Start a new gui frame with gamepad buttons pressed, see inputFrame()
+func padFrame(b *HeadlessBackend, buttons ...int32) {
+	inputFrame(b, func() {
+		for _, button := range buttons {
+			b.SetGamepadButton(0, button, true)
+		}
+	})
+}
+
+func TestGamepadNavigation(t *testing.T) {
+	b := newTestBackend(t)
+
+	// 2x2 grid of buttons, declared by columns
+	//   0 2
+	//   1 3
+	var pressed [4]bool
+	frame := func() {
+		for i := range pressed {
+			bounds := rl.Rectangle{X: float32(i/2) * 100, Y: float32(i%2) * 40, Width: 80, Height: 30}
+			pressed[i] = Button(bounds, "Button")
+		}
+	}
+	pressedButton := func() int {
+		for i, p := range pressed {
+			if p {
+				return i
+			}
+		}
+		return -1
+	}
+
+	mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+	frame()
+	padFrame(b, gamepadButtonRightFaceDown)
+	frame()
+	if got := pressedButton(); got != -1 {
+		t.Fatalf("button %d pressed without focus", got)
+	}
+
+	// D-pad focuses first control
+	padFrame(b, gamepadButtonLeftFaceDown)
+	frame()
+	padFrame(b, gamepadButtonRightFaceDown)
+	frame()
+	if got := pressedButton(); got != 0 {
+		t.Fatalf("pressed button = %d, want 0", got)
+	}
+
+	for i, step := range []struct {
+		direction int32
+		want      int
+	}{
+		{gamepadButtonLeftFaceRight, 2},
+		{gamepadButtonLeftFaceDown, 3},
+		{gamepadButtonLeftFaceLeft, 1},
+		{gamepadButtonLeftFaceLeft, 1}, // Nothing further left, focus stays
+		{gamepadButtonLeftFaceUp, 0},
+	} {
+		padFrame(b, step.direction)
+		frame()
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		if got := pressedButton(); got != step.want {
+			t.Fatalf("step %d: pressed button = %d, want %d", i, got, step.want)
+		}
+	}
+}
+
+func TestGamepadEditMode(t *testing.T) {
+	t.Run("dropdown box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active, editMode := 0, false
+		var pressed bool
+		frame := func() {
+			if DropdownBox(rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}, "A;B;C", &active, editMode) {
+				editMode = !editMode
+			}
+			pressed = Button(rl.Rectangle{X: 0, Y: 200, Width: 80, Height: 20}, "OK")
+		}
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		padFrame(b, gamepadButtonLeftFaceDown)
+		frame()
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		if !editMode {
+			t.Fatal("A did not open dropdown")
+		}
+
+		// D-pad changes the item of the open dropdown instead of moving focus
+		padFrame(b, gamepadButtonLeftFaceDown)
+		frame()
+		if active != 1 || !editMode {
+			t.Fatalf("active, editMode = %d, %v, want 1, open", active, editMode)
+		}
+
+		// B closes the dropdown restoring the item active when it was opened
+		padFrame(b, gamepadButtonRightFaceRight)
+		frame()
+		if active != 0 || editMode {
+			t.Fatalf("active, editMode = %d, %v, want 0, closed", active, editMode)
+		}
+
+		// A closes the dropdown keeping the new item
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		padFrame(b, gamepadButtonLeftFaceDown)
+		frame()
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		if active != 1 || editMode {
+			t.Fatalf("active, editMode = %d, %v, want 1, closed", active, editMode)
+		}
+
+		// Closed dropdown lets d-pad move focus again
+		padFrame(b, gamepadButtonLeftFaceDown)
+		frame()
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		if !pressed || editMode {
+			t.Errorf("pressed, editMode = %v, %v, want button below pressed", pressed, editMode)
+		}
+	})
+
+	t.Run("text box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text, editMode := "abc", false
+		frame := func() {
+			var toggle bool
+			if text, toggle = TextBox(rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 30}, text, 64, editMode); toggle {
+				editMode = !editMode
+			}
+		}
+
+		mouseFrame(b, rl.Vector2{X: -100, Y: -100}, false)
+		frame()
+		padFrame(b, gamepadButtonLeftFaceUp)
+		frame()
+		padFrame(b, gamepadButtonRightFaceDown)
+		frame()
+		if !editMode {
+			t.Fatal("A did not start edit mode")
+		}
+
+		// B ends edit mode restoring the text it started with
+		padFrame(b)
+		b.TypeText("d")
+		frame()
+		if text != "abcd" || !editMode {
+			t.Fatalf("text, editMode = %q, %v, want \"abcd\", editing", text, editMode)
+		}
+		padFrame(b, gamepadButtonRightFaceRight)
+		frame()
+		if text != "abc" || editMode {
+			t.Errorf("text, editMode = %q, %v, want \"abc\", ended", text, editMode)
+		}
+	})
+}
@@ -0,0 +1,285 @@
+package raygui
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Headless backend draw call kind
+type DrawCallKind int
+
+const (
+	DrawRectangleCall DrawCallKind = iota
+	DrawRectangleGradientVCall
+	DrawRectangleGradientExCall
+	DrawTriangleCall
+	DrawTextureRecCall
+	DrawTextCall
+	BeginScissorModeCall
+	EndScissorModeCall
+)
+
+// Headless backend recorded draw call
+// NOTE: Only the fields used by the call kind are set
+type DrawCall struct {
+	Kind     DrawCallKind
+	Rec      rl.Rectangle  // Rectangle, gradient, texture source or scissor area
+	Position rl.Vector2    // Texture or text position
+	Points   [3]rl.Vector2 // Triangle vertices
+	Colors   [4]rl.Color   // Color (or tint) first, gradients use as many as required
+	Text     string        // Text drawn
+	FontSize float32       // Text font size
+	Spacing  float32       // Text spacing
+	Texture  rl.Texture2D  // Texture drawn
+}
+
+// Headless backend glyph advance relative to font size, used to measure text
+const HeadlessGlyphWidth = 0.5
+
+// In-memory backend to run raygui without a window
+// NOTE: Input is scripted frame by frame and every draw call is recorded,
+// text is measured as fixed width glyphs (see HeadlessGlyphWidth)
+type HeadlessBackend struct {
+	MousePosition  rl.Vector2 // Mouse position for current frame
+	MouseWheelMove int32      // Mouse wheel movement for current frame
+	Time           float32    // Elapsed time in seconds
+	FrameTime      float32    // Time advanced by every frame
+	Clipboard      string     // Clipboard text content
+
+	DrawCalls []DrawCall // Draw calls recorded during current frame
+
+	mouseButtons     map[int32]bool
+	prevMouseButtons map[int32]bool
+	keys             map[int32]bool
+	prevKeys         map[int32]bool
+	chars            []int32
+
+	gamepadButtons     map[[2]int32]bool
+	prevGamepadButtons map[[2]int32]bool
+}
+
+var _ Backend = (*HeadlessBackend)(nil)
+
+// Create headless backend, running at 60 frames per second
+func NewHeadlessBackend() *HeadlessBackend {
+	return &HeadlessBackend{
+		FrameTime:        1.0 / 60,
+		mouseButtons:     map[int32]bool{},
+		prevMouseButtons: map[int32]bool{},
+		keys:             map[int32]bool{},
+		prevKeys:         map[int32]bool{},
+
+		gamepadButtons:     map[[2]int32]bool{},
+		prevGamepadButtons: map[[2]int32]bool{},
+	}
+}
+
+// Start a new frame: current input becomes previous frame input,
+// mouse wheel, typed characters and recorded draw calls are reset
+func (b *HeadlessBackend) NextFrame() {
+	for button, down := range b.mouseButtons {
+		b.prevMouseButtons[button] = down
+	}
+	for key, down := range b.keys {
+		b.prevKeys[key] = down
+	}
+	for button, down := range b.gamepadButtons {
+		b.prevGamepadButtons[button] = down
+	}
+
+	b.MouseWheelMove = 0
+	b.chars = b.chars[:0]
+	b.DrawCalls = b.DrawCalls[:0]
+	b.Time += b.FrameTime
+}
+
+// Set mouse button state for current frame
+func (b *HeadlessBackend) SetMouseButton(button int32, down bool) {
+	b.mouseButtons[button] = down
+}
+
+// Set key state for current frame
+func (b *HeadlessBackend) SetKey(key int32, down bool) {
+	b.keys[key] = down
+}
+
+// Set gamepad button state for current frame, the gamepad becomes available
+func (b *HeadlessBackend) SetGamepadButton(gamepad, button int32, down bool) {
+	b.gamepadButtons[[2]int32{gamepad, button}] = down
+}
+
+// Queue text characters to be returned by GetCharPressed() during current frame
+func (b *HeadlessBackend) TypeText(text string) {
+	for _, r := range text {
+		b.chars = append(b.chars, r)
+	}
+}
+
+// Get draw calls of one kind recorded during current frame
+func (b *HeadlessBackend) DrawCallsOf(kind DrawCallKind) []DrawCall {
+	var calls []DrawCall
+	for _, call := range b.DrawCalls {
+		if call.Kind == kind {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Get texts drawn during current frame, in drawing order
+func (b *HeadlessBackend) Texts() []string {
+	var texts []string
+	for _, call := range b.DrawCalls {
+		if call.Kind == DrawTextCall {
+			texts = append(texts, call.Text)
+		}
+	}
+	return texts
+}
+
+func (b *HeadlessBackend) GetMousePosition() rl.Vector2 {
+	return b.MousePosition
+}
+
+func (b *HeadlessBackend) GetMouseWheelMove() int32 {
+	return b.MouseWheelMove
+}
+
+func (b *HeadlessBackend) IsMouseButtonDown(button int32) bool {
+	return b.mouseButtons[button]
+}
+
+func (b *HeadlessBackend) IsMouseButtonPressed(button int32) bool {
+	return b.mouseButtons[button] && !b.prevMouseButtons[button]
+}
+
+func (b *HeadlessBackend) IsMouseButtonReleased(button int32) bool {
+	return !b.mouseButtons[button] && b.prevMouseButtons[button]
+}
+
+func (b *HeadlessBackend) IsKeyDown(key int32) bool {
+	return b.keys[key]
+}
+
+func (b *HeadlessBackend) IsKeyPressed(key int32) bool {
+	return b.keys[key] && !b.prevKeys[key]
+}
+
+func (b *HeadlessBackend) GetCharPressed() int32 {
+	if len(b.chars) == 0 {
+		return 0
+	}
+
+	char := b.chars[0]
+	b.chars = b.chars[1:]
+	return char
+}
+
+func (b *HeadlessBackend) IsGamepadAvailable(gamepad int32) bool {
+	for button := range b.gamepadButtons {
+		if button[0] == gamepad {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *HeadlessBackend) IsGamepadButtonPressed(gamepad, button int32) bool {
+	key := [2]int32{gamepad, button}
+	return b.gamepadButtons[key] && !b.prevGamepadButtons[key]
+}
+
+func (b *HeadlessBackend) GetClipboardText() string {
+	return b.Clipboard
+}
+
+func (b *HeadlessBackend) SetClipboardText(text string) {
+	b.Clipboard = text
+}
+
+func (b *HeadlessBackend) GetTime() float32 {
+	return b.Time
+}
+
+func (b *HeadlessBackend) GetFrameTime() float32 {
+	return b.FrameTime
+}
+
+func (b *HeadlessBackend) DrawRectangle(posX, posY, width, height int32, color rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:   DrawRectangleCall,
+		Rec:    rl.Rectangle{X: float32(posX), Y: float32(posY), Width: float32(width), Height: float32(height)},
+		Colors: [4]rl.Color{color},
+	})
+}
+
+func (b *HeadlessBackend) DrawRectangleGradientV(posX, posY, width, height int32, color1, color2 rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:   DrawRectangleGradientVCall,
+		Rec:    rl.Rectangle{X: float32(posX), Y: float32(posY), Width: float32(width), Height: float32(height)},
+		Colors: [4]rl.Color{color1, color2},
+	})
+}
+
+func (b *HeadlessBackend) DrawRectangleGradientEx(rec rl.Rectangle, color1, color2, color3, color4 rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:   DrawRectangleGradientExCall,
+		Rec:    rec,
+		Colors: [4]rl.Color{color1, color2, color3, color4},
+	})
+}
+
+func (b *HeadlessBackend) DrawTriangle(v1, v2, v3 rl.Vector2, color rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:   DrawTriangleCall,
+		Points: [3]rl.Vector2{v1, v2, v3},
+		Colors: [4]rl.Color{color},
+	})
+}
+
+func (b *HeadlessBackend) DrawTextureRec(texture rl.Texture2D, sourceRec rl.Rectangle, position rl.Vector2, tint rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:     DrawTextureRecCall,
+		Rec:      sourceRec,
+		Position: position,
+		Colors:   [4]rl.Color{tint},
+		Texture:  texture,
+	})
+}
+
+func (b *HeadlessBackend) BeginScissorMode(x, y, width, height int32) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind: BeginScissorModeCall,
+		Rec:  rl.Rectangle{X: float32(x), Y: float32(y), Width: float32(width), Height: float32(height)},
+	})
+}
+
+func (b *HeadlessBackend) EndScissorMode() {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{Kind: EndScissorModeCall})
+}
+
+func (b *HeadlessBackend) GetFontDefault() rl.Font {
+	return rl.Font{BaseSize: 10}
+}
+
+func (b *HeadlessBackend) MeasureTextEx(font rl.Font, text string, fontSize float32, spacing float32) rl.Vector2 {
+	glyphs := 0
+	for range text {
+		glyphs++
+	}
+	if glyphs == 0 {
+		return rl.Vector2{X: 0, Y: fontSize}
+	}
+
+	return rl.Vector2{X: float32(glyphs)*fontSize*HeadlessGlyphWidth + float32(glyphs-1)*spacing, Y: fontSize}
+}
+
+func (b *HeadlessBackend) DrawTextEx(font rl.Font, text string, position rl.Vector2, fontSize float32, spacing float32, tint rl.Color) {
+	b.DrawCalls = append(b.DrawCalls, DrawCall{
+		Kind:     DrawTextCall,
+		Position: position,
+		Colors:   [4]rl.Color{tint},
+		Text:     text,
+		FontSize: fontSize,
+		Spacing:  spacing,
+	})
+}
@@ -0,0 +1,134 @@
+package raygui
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// Build icons file with icon names, each icon filled with its index
+func rgiFile(iconSize int16, names ...string) []byte {
+	var buf bytes.Buffer
+	header := rgiHeader{Version: 100, IconCount: int16(len(names)), IconSize: iconSize}
+	copy(header.Signature[:], rgiSignature)
+	binary.Write(&buf, binary.LittleEndian, header)
+	for _, name := range names {
+		var nameId [RIconMaxNameLength]byte
+		copy(nameId[:], name)
+		buf.Write(nameId[:])
+	}
+	for i := range names {
+		for k := 0; k < RIconDataElements; k++ {
+			binary.Write(&buf, binary.LittleEndian, uint32(i+1))
+		}
+	}
+	return buf.Bytes()
+}
+
+func TestLoadIconsFromReader(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		newTestBackend(t)
+
+		longName := strings.Repeat("x", RIconMaxNameLength)
+		names, err := LoadIconsFromReader(bytes.NewReader(rgiFile(RIconSize, "first", "", longName)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// NUL padding is not part of names, names using all bytes are kept whole
+		if len(names) != 3 || names[0] != "first" || names[1] != "" || names[2] != longName {
+			t.Errorf("names = %q, want %q", names, []string{"first", "", longName})
+		}
+		for iconId := 0; iconId < 3; iconId++ {
+			if data := GetIconData(iconId); data[0] != uint32(iconId+1) || data[RIconDataElements-1] != uint32(iconId+1) {
+				t.Errorf("icon %d data = %v, want filled with %d", iconId, data, iconId+1)
+			}
+		}
+		if GetIconData(3) != defaultIconData(3) {
+			t.Error("icon after loaded icons changed, want default icon kept")
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+	}{
+		{"empty", nil},
+		{"invalid signature", append([]byte("rGS "), rgiFile(RIconSize, "first")[4:]...)},
+		{"icon size mismatch", rgiFile(RIconSize*2, "first")},
+		{"truncated header", rgiFile(RIconSize, "first")[:10]},
+		{"truncated names", rgiFile(RIconSize, "first", "second")[:12+RIconMaxNameLength+4]},
+		{"truncated data", func() []byte { data := rgiFile(RIconSize, "first", "second"); return data[:len(data)-4] }()},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestBackend(t)
+
+			if _, err := LoadIconsFromReader(bytes.NewReader(tt.data)); err == nil {
+				t.Fatal("no error loading invalid icons")
+			}
+			if GetIconData(0) != defaultIconData(0) {
+				t.Error("invalid icons file changed icons")
+			}
+		})
+	}
+}
+
+func TestIconPixel(t *testing.T) {
+	newTestBackend(t)
+
+	const iconId = 1
+	SetIconData(iconId, [RIconDataElements]uint32{})
+
+	corners := [][2]int{{0, 0}, {RIconSize - 1, 0}, {0, RIconSize - 1}, {RIconSize - 1, RIconSize - 1}}
+	for _, p := range corners {
+		SetIconPixel(iconId, p[0], p[1])
+		if !CheckIconPixel(iconId, p[0], p[1]) {
+			t.Errorf("pixel %v not set", p)
+		}
+	}
+
+	// Only the corner pixels are set
+	set := 0
+	for y := 0; y < RIconSize; y++ {
+		for x := 0; x < RIconSize; x++ {
+			if CheckIconPixel(iconId, x, y) {
+				set++
+			}
+		}
+	}
+	if set != len(corners) {
+		t.Errorf("%d pixels set, want %d", set, len(corners))
+	}
+	if data := GetIconData(iconId); data[0] != 1|1<<(RIconSize-1) || data[RIconDataElements-1] != 1<<(32-RIconSize)|1<<31 {
+		t.Errorf("icon data = %#x, want corner bits of first and last elements", data)
+	}
+
+	for _, p := range corners {
+		ClearIconPixel(iconId, p[0], p[1])
+		if CheckIconPixel(iconId, p[0], p[1]) {
+			t.Errorf("pixel %v not cleared", p)
+		}
+	}
+	if GetIconData(iconId) != [RIconDataElements]uint32{} {
+		t.Error("icon data not empty after clearing pixels")
+	}
+
+	// Pixels outside the icon and icons outside the set are ignored
+	for _, p := range [][3]int{{iconId, -1, 0}, {iconId, RIconSize, 0}, {iconId, 0, -1}, {iconId, 0, RIconSize}, {-1, 0, 0}, {RIconMaxIcons, 0, 0}} {
+		SetIconPixel(p[0], p[1], p[2])
+		if CheckIconPixel(p[0], p[1], p[2]) {
+			t.Errorf("pixel %v outside icons set", p)
+		}
+	}
+	if GetIconData(iconId) != [RIconDataElements]uint32{} {
+		t.Error("pixel outside icon set inside it")
+	}
+}
+
+// Get icon data of default icons set
+func defaultIconData(iconId int) [RIconDataElements]uint32 {
+	var data [RIconDataElements]uint32
+	copy(data[:], defaultIcons[iconId*RIconDataElements:])
+	return data
+}
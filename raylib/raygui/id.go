@@ -0,0 +1,266 @@
+package raygui
+
+import (
+	"hash/fnv"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Control identifier for ID-based controls, 0 means no control
+// NOTE: ID-based controls keep edit mode, open state, scroll and cursor position
+// in the context, so callers don't need to keep them between frames (see BeginFrame())
+type ID uint32
+
+// Create control identifier from a label
+func NewID(label string) ID {
+	h := fnv.New32a()
+	h.Write([]byte(label))
+
+	return ID(h.Sum32())
+}
+
+// Create identifier for an indexed child control, e.g. controls created in a loop
+func (id ID) Index(index int) ID {
+	h := fnv.New32a()
+	h.Write([]byte{byte(id), byte(id >> 8), byte(id >> 16), byte(id >> 24)})
+	h.Write([]byte{byte(index), byte(index >> 8), byte(index >> 16), byte(index >> 24)})
+
+	return ID(h.Sum32())
+}
+
+// ID-based control state retained between frames
+type idState struct {
+	editMode    bool       // Edit mode (or open state) in last frame
+	scroll      rl.Vector2 // Scroll position -- TextBoxMultiID(), ScrollPanelID()
+	cursor      int        // Cursor position, -1 for end of text -- TextBoxMultiID()
+	scrollIndex int        // First visible item -- ListViewID()
+	undo        undoStack  // Undo history -- TextBoxID(), TextBoxProID(), TextBoxMultiID(), ValueBoxID(), SpinnerID(), FloatBoxID(), DragFloatID()
+	frame       uint64     // Last frame the control was drawn in, see BeginFrame()
+}
+
+// Get retained state of control, created on first use
+func (ctx *Context) idState(id ID) *idState {
+	if ctx.ids == nil {
+		ctx.ids = map[ID]*idState{}
+	}
+
+	s, ok := ctx.ids[id]
+	if !ok {
+		s = &idState{cursor: -1}
+		ctx.ids[id] = s
+	}
+	return s
+}
+
+// Start a new frame of ID-based controls -- BeginFrame()
+// NOTE: Hot control is found again every frame, and state of controls not drawn
+// in the frame that ended is dropped, so controls that are no longer drawn
+// don't keep their state forever
+func (ctx *Context) beginIDFrame() {
+	ctx.hotID = 0
+
+	for id, s := range ctx.ids {
+		if s.frame != ctx.frame {
+			delete(ctx.ids, id)
+			if ctx.activeID == id {
+				ctx.activeID = 0
+			}
+		}
+	}
+}
+
+// Update hot and active controls with mouse state over control bounds
+func (ctx *Context) updateID(id ID, bounds rl.Rectangle) {
+	ctx.idState(id).frame = ctx.frame
+
+	if ctx.state == StateDisabled || ctx.locked {
+		return
+	}
+
+	if rl.CheckCollisionPointRec(ctx.backend.GetMousePosition(), bounds) {
+		ctx.hotID = id
+		if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+			ctx.activeID = id
+		}
+	} else if ctx.hotID == id {
+		ctx.hotID = 0
+	}
+
+	if ctx.activeID == id && !ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+		ctx.activeID = 0
+	}
+}
+
+// Get control edit mode, edit mode ends when focus moves to another control
+func (ctx *Context) idEditMode(id ID) (editMode, ended bool) {
+	s := ctx.idState(id)
+
+	editMode = ctx.focusedID == id
+	ended = s.editMode && !editMode
+	s.editMode = editMode
+
+	return editMode, ended
+}
+
+// Toggle control edit mode when pressed, returns true if edit mode ended
+func (ctx *Context) idToggleEditMode(id ID, pressed bool) bool {
+	if !pressed {
+		return false
+	}
+
+	s := ctx.idState(id)
+	s.editMode = !s.editMode
+
+	if s.editMode {
+		ctx.focusedID = id
+		return false
+	}
+
+	if ctx.focusedID == id {
+		ctx.focusedID = 0
+	}
+	return true
+}
+
+// Get control under the mouse
+func (ctx *Context) HotID() ID {
+	return ctx.hotID
+}
+
+// Get control being interacted with, while mouse button is held down
+func (ctx *Context) ActiveID() ID {
+	return ctx.activeID
+}
+
+// Get control in edit mode, 0 if none
+func (ctx *Context) FocusedID() ID {
+	return ctx.focusedID
+}
+
+// Set control in edit mode, 0 ends edit mode of focused control
+func (ctx *Context) SetFocusedID(id ID) {
+	ctx.focusedID = id
+}
+
+// Forget retained state of all ID-based controls
+func (ctx *Context) ResetIDs() {
+	ctx.hotID = 0
+	ctx.activeID = 0
+	ctx.focusedID = 0
+	ctx.ids = nil
+}
+
+// Text Box control with retained edit mode, returns true when editing ends
+func (ctx *Context) TextBoxID(id ID, bounds rl.Rectangle, text string, textSize int) (string, bool) {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	text, pressed := ctx.TextBox(bounds, text, textSize, editMode)
+	ctx.undoID = 0
+
+	return text, ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Text Box control with input options and retained edit mode, returns true when editing ends
+func (ctx *Context) TextBoxProID(id ID, bounds rl.Rectangle, text string, options TextBoxOptions) (string, bool) {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	text, pressed := ctx.TextBoxPro(bounds, text, options, editMode)
+	ctx.undoID = 0
+
+	return text, ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Text Box control with multiple lines and retained edit mode, scroll and cursor
+// position, returns true when editing ends
+func (ctx *Context) TextBoxMultiID(id ID, bounds rl.Rectangle, text string, textSize int) (string, bool) {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	s := ctx.idState(id)
+	ctx.undoID = id
+	text, pressed := ctx.TextBoxMulti(bounds, text, textSize, &s.scroll, &s.cursor, editMode)
+	ctx.undoID = 0
+
+	return text, ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Value Box control with retained edit mode, returns true when editing ends
+func (ctx *Context) ValueBoxID(id ID, bounds rl.Rectangle, text string, value *int, minValue, maxValue int) bool {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	pressed := ctx.ValueBox(bounds, text, value, minValue, maxValue, editMode)
+	ctx.undoID = 0
+
+	return ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Spinner control with retained edit mode, returns true when editing ends
+func (ctx *Context) SpinnerID(id ID, bounds rl.Rectangle, text string, value *int, minValue, maxValue int) bool {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	pressed := ctx.Spinner(bounds, text, value, minValue, maxValue, editMode)
+	ctx.undoID = 0
+
+	return ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Float Box control with retained edit mode, returns true when editing ends
+func (ctx *Context) FloatBoxID(id ID, bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32) bool {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	pressed := ctx.FloatBox(bounds, text, value, minValue, maxValue, editMode)
+	ctx.undoID = 0
+
+	return ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Drag Float control with retained edit mode, returns true when editing ends
+func (ctx *Context) DragFloatID(id ID, bounds rl.Rectangle, text string, value *float32, minValue, maxValue float32) bool {
+	ctx.updateID(id, bounds)
+	editMode, ended := ctx.idEditMode(id)
+
+	ctx.undoID = id
+	pressed := ctx.DragFloat(bounds, text, value, minValue, maxValue, editMode)
+	ctx.undoID = 0
+
+	return ctx.idToggleEditMode(id, pressed) || ended
+}
+
+// Dropdown Box control with retained open state, returns true when active item changes
+func (ctx *Context) DropdownBoxID(id ID, bounds rl.Rectangle, text string, active *int) bool {
+	ctx.updateID(id, bounds)
+	editMode, _ := ctx.idEditMode(id)
+
+	prevActive := *active
+	pressed := ctx.DropdownBox(bounds, text, active, editMode)
+	ctx.idToggleEditMode(id, pressed)
+
+	return *active != prevActive
+}
+
+// List View control with retained scroll index, returns selected list item index
+func (ctx *Context) ListViewID(id ID, bounds rl.Rectangle, text string, active int) int {
+	ctx.updateID(id, bounds)
+
+	return ctx.ListView(bounds, text, &ctx.idState(id).scrollIndex, active)
+}
+
+// Scroll Panel control with retained scroll, returns view area and scroll
+func (ctx *Context) ScrollPanelID(id ID, bounds, content rl.Rectangle) (rl.Rectangle, rl.Vector2) {
+	ctx.updateID(id, bounds)
+
+	s := ctx.idState(id)
+	view := ctx.ScrollPanel(bounds, content, &s.scroll)
+
+	return view, s.scroll
+}
@@ -0,0 +1,162 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestNewID(t *testing.T) {
+	if NewID("name") != NewID("name") {
+		t.Error("same label gave different ids")
+	}
+	if NewID("name") == NewID("other") {
+		t.Error("different labels gave same id")
+	}
+
+	id := NewID("list")
+	if id.Index(0) == id.Index(1) || id.Index(0) == id {
+		t.Error("indexed ids not unique")
+	}
+}
+
+func TestTextBoxID(t *testing.T) {
+	first := NewID("first")
+	second := NewID("second")
+	firstBounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+	secondBounds := rl.Rectangle{X: 0, Y: 30, Width: 100, Height: 20}
+
+	b := newTestBackend(t)
+
+	firstText, secondText := "", ""
+	var firstEnded, secondEnded bool
+	frame := func() {
+		firstText, firstEnded = TextBoxID(first, firstBounds, firstText, 32)
+		secondText, secondEnded = TextBoxID(second, secondBounds, secondText, 32)
+	}
+
+	click(b, rl.Vector2{X: 10, Y: 10}, frame)
+	if FocusedID() != first {
+		t.Fatalf("focused id = %v, want first text box", FocusedID())
+	}
+
+	b.NextFrame()
+	b.TypeText("ab")
+	frame()
+	frame()
+	if firstText != "ab" || secondText != "" {
+		t.Errorf("texts = %q, %q, want typing into first text box only", firstText, secondText)
+	}
+
+	// Clicking another text box moves focus and ends first text box editing
+	mouseFrame(b, rl.Vector2{X: 10, Y: 40}, true)
+	frame()
+	if !firstEnded {
+		t.Error("first text box editing did not end")
+	}
+	if FocusedID() != second {
+		t.Errorf("focused id = %v, want second text box", FocusedID())
+	}
+
+	// Clicking outside ends editing
+	mouseFrame(b, rl.Vector2{X: 10, Y: 40}, false)
+	frame()
+	mouseFrame(b, rl.Vector2{X: 300, Y: 300}, true)
+	frame()
+	if !secondEnded || firstEnded {
+		t.Errorf("ended = %v, %v, want second text box only", firstEnded, secondEnded)
+	}
+	if FocusedID() != 0 {
+		t.Errorf("focused id = %v after clicking outside, want none", FocusedID())
+	}
+}
+
+func TestDropdownBoxID(t *testing.T) {
+	id := NewID("dropdown")
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+
+	b := newTestBackend(t)
+
+	active := 0
+	var changed bool
+	click(b, rl.Vector2{X: 10, Y: 10}, func() { changed = DropdownBoxID(id, bounds, "A;B;C", &active) })
+	if FocusedID() != id || changed {
+		t.Fatalf("focused id = %v, changed = %v, want open dropdown", FocusedID(), changed)
+	}
+
+	// Items are drawn below the box, select the second one
+	item := rl.Vector2{X: 10, Y: 2*(bounds.Height+float32(GetStyle(DropdownBoxControl, DropdownItemsPadding))) + 10}
+	click(b, item, func() {
+		if DropdownBoxID(id, bounds, "A;B;C", &active) {
+			changed = true
+		}
+	})
+	if !changed || active != 1 {
+		t.Errorf("changed = %v, active = %d, want item 1 selected", changed, active)
+	}
+	if FocusedID() != 0 {
+		t.Error("dropdown still open after selecting an item")
+	}
+}
+
+func TestHotActiveID(t *testing.T) {
+	id := NewID("list")
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 100}
+
+	b := newTestBackend(t)
+
+	mouseFrame(b, rl.Vector2{X: 10, Y: 10}, false)
+	ListViewID(id, bounds, "A;B", -1)
+	if HotID() != id || ActiveID() != 0 {
+		t.Errorf("hot, active = %v, %v, want hot list", HotID(), ActiveID())
+	}
+
+	mouseFrame(b, rl.Vector2{X: 10, Y: 10}, true)
+	ListViewID(id, bounds, "A;B", -1)
+	if ActiveID() != id {
+		t.Errorf("active id = %v, want list", ActiveID())
+	}
+
+	mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+	ListViewID(id, bounds, "A;B", -1)
+	if HotID() != 0 || ActiveID() != 0 {
+		t.Errorf("hot, active = %v, %v, want none", HotID(), ActiveID())
+	}
+}
+
+func TestIDFrame(t *testing.T) {
+	b := newTestBackend(t)
+	inside := rl.Vector2{X: 10, Y: 10}
+	list, panel := NewID("list"), NewID("panel")
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 100}
+	content := rl.Rectangle{Width: 100, Height: 300}
+
+	mouseFrame(b, inside, false)
+	ListViewID(list, bounds, "A;B", -1)
+	if HotID() != list {
+		t.Fatalf("hot id = %v, want list", HotID())
+	}
+
+	// Hot control is cleared when it is no longer drawn under the mouse
+	mouseFrame(b, inside, false)
+	if HotID() != 0 {
+		t.Errorf("hot id = %v in new frame, want none", HotID())
+	}
+
+	// Retained state of controls not drawn in last frame is dropped
+	b.MouseWheelMove = -1
+	ScrollPanelID(panel, bounds, content)
+	mouseFrame(b, inside, false)
+	if _, scroll := ScrollPanelID(panel, bounds, content); scroll.Y == 0 {
+		t.Fatal("scroll panel not scrolled by mouse wheel")
+	}
+	if _, ok := defaultContext.ids[list]; ok {
+		t.Error("list state kept after a frame without list")
+	}
+
+	mouseFrame(b, inside, false)
+	mouseFrame(b, inside, false)
+	if _, scroll := ScrollPanelID(panel, bounds, content); scroll.Y != 0 {
+		t.Errorf("scroll = %v after a frame without panel, want state dropped", scroll)
+	}
+}
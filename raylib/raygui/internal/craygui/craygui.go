@@ -0,0 +1,427 @@
+// Package craygui wraps the bundled C raygui.h, compiled in standalone mode,
+// to compare the Go port against upstream.
+//
+// Input is scripted frame by frame and every draw call is recorded, as
+// raygui.HeadlessBackend does: text is measured as fixed width glyphs of half
+// the font size. raygui.h keeps global state, so only one caller can use the
+// package at a time.
+package craygui
+
+/*
+#cgo LDFLAGS: -lm
+#include <stdlib.h>
+#include "craygui.h"
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Draw call kind, same values as raygui.DrawCallKind
+type DrawCallKind int
+
+const (
+	DrawRectangleCall DrawCallKind = iota
+	DrawRectangleGradientVCall
+	DrawRectangleGradientExCall
+	DrawTriangleCall
+	DrawTextureRecCall
+	DrawTextCall
+)
+
+// Recorded draw call, same fields as raygui.DrawCall
+// NOTE: raygui.h draws vertical gradients with DrawRectangleGradientEx(),
+// so DrawRectangleGradientVCall is never recorded
+type DrawCall struct {
+	Kind     DrawCallKind
+	Rec      rl.Rectangle
+	Position rl.Vector2
+	Points   [3]rl.Vector2
+	Colors   [4]rl.Color
+	Text     string
+	FontSize float32
+	Spacing  float32
+	Texture  rl.Texture2D
+}
+
+// Reset scripted input and recorded draw calls
+// NOTE: Gui global state is not reset, see Enable(), Unlock(), Fade() and LoadStyleDefault()
+func Reset() {
+	C.crayguiFrame = C.CrayguiFrame{}
+}
+
+// Start a new frame: current input becomes previous frame input,
+// mouse wheel, typed characters and recorded draw calls are reset
+func NextFrame() {
+	C.CrayguiNextFrame()
+}
+
+// Set mouse position for current frame
+func SetMousePosition(position rl.Vector2) {
+	C.crayguiFrame.mousePosition = cVector2(position)
+}
+
+// Set mouse wheel movement for current frame
+func SetMouseWheelMove(move int32) {
+	C.crayguiFrame.mouseWheelMove = C.int(move)
+}
+
+// Set mouse button state for current frame
+func SetMouseButton(button int32, down bool) {
+	if button >= 0 && button < C.CRAYGUI_MAX_MOUSE_BUTTONS {
+		C.crayguiFrame.mouseButtons[button] = C.bool(down)
+	}
+}
+
+// Set key state for current frame
+func SetKey(key int32, down bool) {
+	if key >= 0 && key < C.CRAYGUI_MAX_KEYS {
+		C.crayguiFrame.keys[key] = C.bool(down)
+	}
+}
+
+// Queue text characters to be returned by GetCharPressed() during current frame
+func TypeText(text string) {
+	for _, r := range text {
+		if C.crayguiFrame.charCount < C.CRAYGUI_MAX_CHARS {
+			C.crayguiFrame.chars[C.crayguiFrame.charCount] = C.int(r)
+			C.crayguiFrame.charCount++
+		}
+	}
+}
+
+// Get draw calls recorded during current frame
+func DrawCalls() []DrawCall {
+	calls := make([]DrawCall, int(C.crayguiFrame.drawCallCount))
+	for i := range calls {
+		call := &C.crayguiFrame.drawCalls[i]
+
+		calls[i] = DrawCall{
+			Kind:     DrawCallKind(call.kind),
+			Rec:      goRectangle(call.rec),
+			Position: goVector2(call.position),
+			Text:     C.GoString(&call.text[0]),
+			FontSize: float32(call.fontSize),
+			Spacing:  float32(call.spacing),
+			Texture:  goTexture(call.texture),
+		}
+		for j := range calls[i].Points {
+			calls[i].Points[j] = goVector2(call.points[j])
+		}
+		for j := range calls[i].Colors {
+			calls[i].Colors[j] = goColor(call.colors[j])
+		}
+	}
+	return calls
+}
+
+// Global gui state control functions
+func Enable() {
+	C.GuiEnable()
+}
+
+func Disable() {
+	C.GuiDisable()
+}
+
+func Lock() {
+	C.GuiLock()
+}
+
+func Unlock() {
+	C.GuiUnlock()
+}
+
+func Fade(alpha float32) {
+	C.GuiFade(C.float(alpha))
+}
+
+func SetState(state int) {
+	C.GuiSetState(C.int(state))
+}
+
+func GetState() int {
+	return int(C.GuiGetState())
+}
+
+// Style set/get functions
+func SetStyle(control, property int, value uint) {
+	C.GuiSetStyle(C.int(control), C.int(property), C.int(int32(value)))
+}
+
+func GetStyle(control, property int) uint {
+	return uint(uint32(C.GuiGetStyle(C.int(control), C.int(property))))
+}
+
+func LoadStyleDefault() {
+	C.GuiLoadStyleDefault()
+}
+
+// Container/separator controls
+func WindowBox(bounds rl.Rectangle, title string) bool {
+	ctitle := C.CString(title)
+	defer C.free(unsafe.Pointer(ctitle))
+
+	return bool(C.GuiWindowBox(cRectangle(bounds), ctitle))
+}
+
+func GroupBox(bounds rl.Rectangle, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.GuiGroupBox(cRectangle(bounds), ctext)
+}
+
+func Line(bounds rl.Rectangle, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.GuiLine(cRectangle(bounds), ctext)
+}
+
+func Panel(bounds rl.Rectangle) {
+	C.GuiPanel(cRectangle(bounds))
+}
+
+func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle {
+	cscroll := cVector2(*scroll)
+	view := C.GuiScrollPanel(cRectangle(bounds), cRectangle(content), &cscroll)
+	*scroll = goVector2(cscroll)
+
+	return goRectangle(view)
+}
+
+// Basic controls set
+func Label(bounds rl.Rectangle, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.GuiLabel(cRectangle(bounds), ctext)
+}
+
+func Button(bounds rl.Rectangle, text string) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return bool(C.GuiButton(cRectangle(bounds), ctext))
+}
+
+func LabelButton(bounds rl.Rectangle, text string) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return bool(C.GuiLabelButton(cRectangle(bounds), ctext))
+}
+
+func ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSource rl.Rectangle) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return bool(C.GuiImageButtonEx(cRectangle(bounds), ctext, cTexture(texture), cRectangle(texSource)))
+}
+
+func Toggle(bounds rl.Rectangle, text string, active bool) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return bool(C.GuiToggle(cRectangle(bounds), ctext, C.bool(active)))
+}
+
+func ToggleGroup(bounds rl.Rectangle, text string, active int) int {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return int(C.GuiToggleGroup(cRectangle(bounds), ctext, C.int(active)))
+}
+
+func CheckBox(bounds rl.Rectangle, text string, checked bool) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return bool(C.GuiCheckBox(cRectangle(bounds), ctext, C.bool(checked)))
+}
+
+func ComboBox(bounds rl.Rectangle, text string, active int) int {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return int(C.GuiComboBox(cRectangle(bounds), ctext, C.int(active)))
+}
+
+func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cactive := C.int(*active)
+	pressed := C.GuiDropdownBox(cRectangle(bounds), ctext, &cactive, C.bool(editMode))
+	*active = int(cactive)
+
+	return bool(pressed)
+}
+
+func Spinner(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cvalue := C.int(*value)
+	pressed := C.GuiSpinner(cRectangle(bounds), ctext, &cvalue, C.int(minValue), C.int(maxValue), C.bool(editMode))
+	*value = int(cvalue)
+
+	return bool(pressed)
+}
+
+func ValueBox(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cvalue := C.int(*value)
+	pressed := C.GuiValueBox(cRectangle(bounds), ctext, &cvalue, C.int(minValue), C.int(maxValue), C.bool(editMode))
+	*value = int(cvalue)
+
+	return bool(pressed)
+}
+
+func TextBox(bounds rl.Rectangle, text string, textSize int, editMode bool) (string, bool) {
+	buffer := textBuffer(text, textSize)
+	defer C.free(unsafe.Pointer(buffer))
+
+	pressed := C.GuiTextBox(cRectangle(bounds), buffer, C.int(textSize), C.bool(editMode))
+
+	return C.GoString(buffer), bool(pressed)
+}
+
+func Slider(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	cleft, cright := C.CString(textLeft), C.CString(textRight)
+	defer C.free(unsafe.Pointer(cleft))
+	defer C.free(unsafe.Pointer(cright))
+
+	return float32(C.GuiSlider(cRectangle(bounds), cleft, cright, C.float(value), C.float(minValue), C.float(maxValue)))
+}
+
+func SliderBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	cleft, cright := C.CString(textLeft), C.CString(textRight)
+	defer C.free(unsafe.Pointer(cleft))
+	defer C.free(unsafe.Pointer(cright))
+
+	return float32(C.GuiSliderBar(cRectangle(bounds), cleft, cright, C.float(value), C.float(minValue), C.float(maxValue)))
+}
+
+func ProgressBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	cleft, cright := C.CString(textLeft), C.CString(textRight)
+	defer C.free(unsafe.Pointer(cleft))
+	defer C.free(unsafe.Pointer(cright))
+
+	return float32(C.GuiProgressBar(cRectangle(bounds), cleft, cright, C.float(value), C.float(minValue), C.float(maxValue)))
+}
+
+func StatusBar(bounds rl.Rectangle, text string) {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	C.GuiStatusBar(cRectangle(bounds), ctext)
+}
+
+func ScrollBar(bounds rl.Rectangle, value, minValue, maxValue int) int {
+	return int(C.GuiScrollBar(cRectangle(bounds), C.int(value), C.int(minValue), C.int(maxValue)))
+}
+
+func Grid(bounds rl.Rectangle, spacing float32, subdivs int) rl.Vector2 {
+	return goVector2(C.GuiGrid(cRectangle(bounds), C.float(spacing), C.int(subdivs)))
+}
+
+// Advance controls set
+func ListView(bounds rl.Rectangle, text string, scrollIndex *int, active int) int {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	cscrollIndex := C.int(*scrollIndex)
+	active = int(C.GuiListView(cRectangle(bounds), ctext, &cscrollIndex, C.int(active)))
+	*scrollIndex = int(cscrollIndex)
+
+	return active
+}
+
+func MessageBox(bounds rl.Rectangle, title, message, buttons string) int {
+	ctitle, cmessage, cbuttons := C.CString(title), C.CString(message), C.CString(buttons)
+	defer C.free(unsafe.Pointer(ctitle))
+	defer C.free(unsafe.Pointer(cmessage))
+	defer C.free(unsafe.Pointer(cbuttons))
+
+	return int(C.GuiMessageBox(cRectangle(bounds), ctitle, cmessage, cbuttons))
+}
+
+func ColorPicker(bounds rl.Rectangle, color rl.Color) rl.Color {
+	return goColor(C.GuiColorPicker(cRectangle(bounds), cColor(color)))
+}
+
+func ColorPanel(bounds rl.Rectangle, color rl.Color) rl.Color {
+	return goColor(C.GuiColorPanel(cRectangle(bounds), cColor(color)))
+}
+
+func ColorBarAlpha(bounds rl.Rectangle, alpha float32) float32 {
+	return float32(C.GuiColorBarAlpha(cRectangle(bounds), C.float(alpha)))
+}
+
+func ColorBarHue(bounds rl.Rectangle, hue float32) float32 {
+	return float32(C.GuiColorBarHue(cRectangle(bounds), C.float(hue)))
+}
+
+// Gui icons functionality
+func IconText(iconId int, text string) string {
+	ctext := C.CString(text)
+	defer C.free(unsafe.Pointer(ctext))
+
+	return C.GoString(C.GuiIconText(C.int(iconId), ctext))
+}
+
+func DrawIcon(iconId int, position rl.Vector2, pixelSize int, color rl.Color) {
+	C.GuiDrawIcon(C.int(iconId), cVector2(position), C.int(pixelSize), cColor(color))
+}
+
+// Allocate text buffer edited in place by raygui.h, at least textSize bytes long
+func textBuffer(text string, textSize int) *C.char {
+	size := len(text) + 1
+	if textSize > size {
+		size = textSize
+	}
+
+	buffer := (*C.char)(C.calloc(C.size_t(size), 1))
+	copy(unsafe.Slice((*byte)(unsafe.Pointer(buffer)), size), text)
+
+	return buffer
+}
+
+func cRectangle(rec rl.Rectangle) C.Rectangle {
+	return C.Rectangle{x: C.float(rec.X), y: C.float(rec.Y), width: C.float(rec.Width), height: C.float(rec.Height)}
+}
+
+func goRectangle(rec C.Rectangle) rl.Rectangle {
+	return rl.Rectangle{X: float32(rec.x), Y: float32(rec.y), Width: float32(rec.width), Height: float32(rec.height)}
+}
+
+func cVector2(v rl.Vector2) C.Vector2 {
+	return C.Vector2{x: C.float(v.X), y: C.float(v.Y)}
+}
+
+func goVector2(v C.Vector2) rl.Vector2 {
+	return rl.Vector2{X: float32(v.x), Y: float32(v.y)}
+}
+
+func cColor(color rl.Color) C.Color {
+	return C.Color{r: C.uchar(color.R), g: C.uchar(color.G), b: C.uchar(color.B), a: C.uchar(color.A)}
+}
+
+func goColor(color C.Color) rl.Color {
+	return rl.Color{R: uint8(color.r), G: uint8(color.g), B: uint8(color.b), A: uint8(color.a)}
+}
+
+func cTexture(texture rl.Texture2D) C.Texture2D {
+	return C.Texture2D{id: C.uint(texture.ID), width: C.int(texture.Width), height: C.int(texture.Height), mipmaps: C.int(texture.Mipmaps), format: C.int(texture.Format)}
+}
+
+func goTexture(texture C.Texture2D) rl.Texture2D {
+	return rl.Texture2D{ID: uint32(texture.id), Width: int32(texture.width), Height: int32(texture.height), Mipmaps: int32(texture.mipmaps), Format: rl.PixelFormat(texture.format)}
+}
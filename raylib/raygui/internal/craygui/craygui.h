@@ -0,0 +1,69 @@
+#ifndef CRAYGUI_H
+#define CRAYGUI_H
+
+#include <stdbool.h>
+
+// raygui.h is used in standalone mode: input is scripted and draw calls are recorded
+#define RAYGUI_STANDALONE
+#define RAYGUI_SUPPORT_RICONS
+
+// NOTE: Image type is required by GlyphInfo but not defined by raygui.h in standalone mode
+typedef struct Image {
+    void *data;
+    int width;
+    int height;
+    int mipmaps;
+    int format;
+} Image;
+
+#include "../../raygui.h"
+
+#define CRAYGUI_MAX_DRAW_CALLS      4096
+#define CRAYGUI_MAX_TEXT_SIZE       256
+#define CRAYGUI_MAX_KEYS            512
+#define CRAYGUI_MAX_MOUSE_BUTTONS   8
+#define CRAYGUI_MAX_CHARS           64
+
+// Draw call kind, same order as raygui.DrawCallKind
+typedef enum {
+    CRAYGUI_DRAW_RECTANGLE = 0,
+    CRAYGUI_DRAW_RECTANGLE_GRADIENT_V,
+    CRAYGUI_DRAW_RECTANGLE_GRADIENT_EX,
+    CRAYGUI_DRAW_TRIANGLE,
+    CRAYGUI_DRAW_TEXTURE_REC,
+    CRAYGUI_DRAW_TEXT,
+} CrayguiDrawCallKind;
+
+// Recorded draw call, only the fields used by the call kind are set
+typedef struct CrayguiDrawCall {
+    int kind;
+    Rectangle rec;
+    Vector2 position;
+    Vector2 points[3];
+    Color colors[4];
+    char text[CRAYGUI_MAX_TEXT_SIZE];
+    float fontSize;
+    float spacing;
+    Texture2D texture;
+} CrayguiDrawCall;
+
+// Scripted input and recorded draw calls
+typedef struct CrayguiFrame {
+    Vector2 mousePosition;
+    int mouseWheelMove;
+    bool mouseButtons[CRAYGUI_MAX_MOUSE_BUTTONS];
+    bool prevMouseButtons[CRAYGUI_MAX_MOUSE_BUTTONS];
+    bool keys[CRAYGUI_MAX_KEYS];
+    bool prevKeys[CRAYGUI_MAX_KEYS];
+    int chars[CRAYGUI_MAX_CHARS];
+    int charCount;
+
+    CrayguiDrawCall drawCalls[CRAYGUI_MAX_DRAW_CALLS];
+    int drawCallCount;
+} CrayguiFrame;
+
+extern CrayguiFrame crayguiFrame;
+
+void CrayguiNextFrame(void);        // Current input becomes previous frame input, per frame state is reset
+
+#endif // CRAYGUI_H
@@ -0,0 +1,228 @@
+#include "craygui.h"
+
+// NOTE: raygui.h in standalone mode misses some definitions used by its implementation,
+// they are provided here so the header is compiled unmodified
+#define BLANK               (Color){ 0, 0, 0, 0 }
+#define KEY_LEFT_SHIFT      340
+#define KEY_RIGHT_SHIFT     344
+
+// NOTE: GuiTextBoxMulti() uses raylib 4.0 Font.glyphs field, named chars in standalone Font
+#define glyphs chars
+
+static const char *CodepointToUTF8(int codepoint, int *byteSize);
+static int GetCodepoint(const char *text, int *bytesProcessed);
+static int GetGlyphIndex(Font font, int codepoint);
+static void DrawTextCodepoint(Font font, int codepoint, Vector2 position, float fontSize, Color tint);
+
+#define RAYGUI_IMPLEMENTATION
+#include "../../raygui.h"
+
+CrayguiFrame crayguiFrame = { 0 };
+
+void CrayguiNextFrame(void)
+{
+    memcpy(crayguiFrame.prevMouseButtons, crayguiFrame.mouseButtons, sizeof(crayguiFrame.mouseButtons));
+    memcpy(crayguiFrame.prevKeys, crayguiFrame.keys, sizeof(crayguiFrame.keys));
+
+    crayguiFrame.mouseWheelMove = 0;
+    crayguiFrame.charCount = 0;
+    crayguiFrame.drawCallCount = 0;
+}
+
+// Get next draw call to record, last one is overwritten when full
+static CrayguiDrawCall *RecordDrawCall(int kind)
+{
+    if (crayguiFrame.drawCallCount < CRAYGUI_MAX_DRAW_CALLS) crayguiFrame.drawCallCount++;
+
+    CrayguiDrawCall *call = &crayguiFrame.drawCalls[crayguiFrame.drawCallCount - 1];
+    memset(call, 0, sizeof(CrayguiDrawCall));
+    call->kind = kind;
+
+    return call;
+}
+
+// Count text glyphs, as Go ranging over a string
+static int CountGlyphs(const char *text)
+{
+    int count = 0;
+
+    for (int i = 0; text[i] != '\0'; i++) if ((text[i] & 0xc0) != 0x80) count++;
+
+    return count;
+}
+
+//----------------------------------------------------------------------------------
+// Input required functions
+//----------------------------------------------------------------------------------
+static Vector2 GetMousePosition(void)
+{
+    return crayguiFrame.mousePosition;
+}
+
+static int GetMouseWheelMove(void)
+{
+    return crayguiFrame.mouseWheelMove;
+}
+
+static bool IsMouseButtonDown(int button)
+{
+    if ((button < 0) || (button >= CRAYGUI_MAX_MOUSE_BUTTONS)) return false;
+
+    return crayguiFrame.mouseButtons[button];
+}
+
+static bool IsMouseButtonPressed(int button)
+{
+    if ((button < 0) || (button >= CRAYGUI_MAX_MOUSE_BUTTONS)) return false;
+
+    return crayguiFrame.mouseButtons[button] && !crayguiFrame.prevMouseButtons[button];
+}
+
+static bool IsMouseButtonReleased(int button)
+{
+    if ((button < 0) || (button >= CRAYGUI_MAX_MOUSE_BUTTONS)) return false;
+
+    return !crayguiFrame.mouseButtons[button] && crayguiFrame.prevMouseButtons[button];
+}
+
+static bool IsKeyDown(int key)
+{
+    if ((key < 0) || (key >= CRAYGUI_MAX_KEYS)) return false;
+
+    return crayguiFrame.keys[key];
+}
+
+static bool IsKeyPressed(int key)
+{
+    if ((key < 0) || (key >= CRAYGUI_MAX_KEYS)) return false;
+
+    return crayguiFrame.keys[key] && !crayguiFrame.prevKeys[key];
+}
+
+static int GetCharPressed(void)
+{
+    if (crayguiFrame.charCount == 0) return 0;
+
+    int key = crayguiFrame.chars[0];
+
+    crayguiFrame.charCount--;
+    memmove(crayguiFrame.chars, crayguiFrame.chars + 1, crayguiFrame.charCount*sizeof(int));
+
+    return key;
+}
+
+//----------------------------------------------------------------------------------
+// Drawing required functions
+//----------------------------------------------------------------------------------
+static void DrawRectangle(int x, int y, int width, int height, Color color)
+{
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_RECTANGLE);
+
+    call->rec = (Rectangle){ (float)x, (float)y, (float)width, (float)height };
+    call->colors[0] = color;
+}
+
+static void DrawRectangleGradientEx(Rectangle rec, Color col1, Color col2, Color col3, Color col4)
+{
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_RECTANGLE_GRADIENT_EX);
+
+    call->rec = rec;
+    call->colors[0] = col1;
+    call->colors[1] = col2;
+    call->colors[2] = col3;
+    call->colors[3] = col4;
+}
+
+static void DrawTriangle(Vector2 v1, Vector2 v2, Vector2 v3, Color color)
+{
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_TRIANGLE);
+
+    call->points[0] = v1;
+    call->points[1] = v2;
+    call->points[2] = v3;
+    call->colors[0] = color;
+}
+
+static void DrawTextureRec(Texture2D texture, Rectangle sourceRec, Vector2 position, Color tint)
+{
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_TEXTURE_REC);
+
+    call->rec = sourceRec;
+    call->position = position;
+    call->colors[0] = tint;
+    call->texture = texture;
+}
+
+//----------------------------------------------------------------------------------
+// Text required functions
+// NOTE: Text is measured as fixed width glyphs, as raygui.HeadlessBackend
+//----------------------------------------------------------------------------------
+static Font GetFontDefault(void)
+{
+    Font font = { 0 };
+    font.baseSize = 10;
+
+    return font;
+}
+
+static Vector2 MeasureTextEx(Font font, const char *text, float fontSize, float spacing)
+{
+    int glyphCount = CountGlyphs(text);
+
+    if (glyphCount == 0) return (Vector2){ 0.0f, fontSize };
+
+    return (Vector2){ (float)glyphCount*fontSize*0.5f + (float)(glyphCount - 1)*spacing, fontSize };
+}
+
+static void DrawTextEx(Font font, const char *text, Vector2 position, float fontSize, float spacing, Color tint)
+{
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_TEXT);
+
+    strncpy(call->text, text, CRAYGUI_MAX_TEXT_SIZE - 1);
+    call->position = position;
+    call->colors[0] = tint;
+    call->fontSize = fontSize;
+    call->spacing = spacing;
+}
+
+// NOTE: Style files are not loaded by the harness
+static Font LoadFontEx(const char *fileName, int fontSize, int *fontChars, int glyphCount)
+{
+    return GetFontDefault();
+}
+
+static char *LoadFileText(const char *fileName)
+{
+    return NULL;
+}
+
+static const char *GetDirectoryPath(const char *filePath)
+{
+    return ".";
+}
+
+// NOTE: Only required to compile GuiTextBoxMulti(), not available in the harness
+static int GetCodepoint(const char *text, int *bytesProcessed)
+{
+    *bytesProcessed = 1;
+
+    return (unsigned char)text[0];
+}
+
+static int GetGlyphIndex(Font font, int codepoint)
+{
+    return 0;
+}
+
+static void DrawTextCodepoint(Font font, int codepoint, Vector2 position, float fontSize, Color tint)
+{
+    int byteSize = 0;
+    const char *text = CodepointToUTF8(codepoint, &byteSize);
+
+    CrayguiDrawCall *call = RecordDrawCall(CRAYGUI_DRAW_TEXT);
+
+    memcpy(call->text, text, byteSize);
+    call->position = position;
+    call->colors[0] = tint;
+    call->fontSize = fontSize;
+}
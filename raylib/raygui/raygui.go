@@ -1,9 +1,35 @@
+// Package raygui is a Go port of raygui, the immediate mode gui library for raylib.
+//
+// Controls are drawn and updated in the same call, every frame, between raylib
+// BeginDrawing() and EndDrawing(). Call BeginFrame() once at the start of every
+// frame, before drawing any control: Tab and gamepad focus navigation,
+// ID-based controls and the indeterminate progress bar need it to know where a
+// frame starts, raygui.h controls drawn without it work as in raygui.h. Without
+// it, ID-based controls no longer drawn keep their state forever.
+//
+//	for !rl.WindowShouldClose() {
+//		rl.BeginDrawing()
+//		raygui.BeginFrame()
+//		if raygui.Button(rl.Rectangle{X: 10, Y: 10, Width: 80, Height: 20}, "OK") {
+//			// ...
+//		}
+//		rl.EndDrawing()
+//	}
 package raygui
 
 import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
 	"fmt"
+	"io"
 	"math"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	rl "github.com/gen2brain/raylib-go/raylib"
 )
@@ -142,6 +168,13 @@ const (
 	SpinButtonPadding
 )
 
+// ValueBox / FloatBox / DragFloat
+// NOTE(port): Following the TextBox properties shared by ValueBox
+const (
+	FloatPrecision ControlProperty = iota + 20 // Decimals shown by float value controls
+	DragSpeed                                  // Value change per pixel dragged, in steps of the last decimal shown
+)
+
 // ScrollBar
 const (
 	ArrowsSize ControlProperty = iota + 16
@@ -196,18 +229,7 @@ const (
 //----------------------------------------------------------------------------------
 // Global Variables Definition
 //----------------------------------------------------------------------------------
-var guiState = StateNormal
-
-var guiFont rl.Font      // Gui current font (WARNING: highly coupled to raylib)
-var guiLocked = false    // Gui lock state (no inputs processed)
-var guiAlpha float32 = 1 // Gui element transpacency on drawing
-
-// Global gui style array (allocated on data segment by default)
-// NOTE: In raygui we manage a single int array with all the possible style properties.
-// When a new style is loaded, it loads over the global style... but default gui style
-// could always be recovered with GuiLoadStyleDefault()
-var guiStyle [MaxControls * (MaxPropsDefault + MaxPropsExtended)]uint
-var guiStyleLoaded = false // Style loaded flag for lazy style initialization
+var defaultContext = NewContext() // Gui context used by package level functions
 
 const RIconSize = 16          // Size of icons (squared)
 const RIconMaxIcons = 256     // Maximum number of icons
@@ -219,12 +241,274 @@ const RIconMaxNameLength = 32 // Maximum length of icon name id
 // Number of elemens depend on RICON_SIZE (by default 16x16 pixels)
 const RIconDataElements = RIconSize * RIconSize / 32
 
+// Gui icons
+type Icon int
+
+const (
+	IconNone Icon = iota
+	IconFolderFileOpen
+	IconFileSaveClassic
+	IconFolderOpen
+	IconFolderSave
+	IconFileOpen
+	IconFileSave
+	IconFileExport
+	IconFileNew
+	IconFileDelete
+	IconFiletypeText
+	IconFiletypeAudio
+	IconFiletypeImage
+	IconFiletypePlay
+	IconFiletypeVideo
+	IconFiletypeInfo
+	IconFileCopy
+	IconFileCut
+	IconFilePaste
+	IconCursorHand
+	IconCursorPointer
+	IconCursorClassic
+	IconPencil
+	IconPencilBig
+	IconBrushClassic
+	IconBrushPainter
+	IconWaterDrop
+	IconColorPicker
+	IconRubber
+	IconColorBucket
+	IconTextT
+	IconTextA
+	IconScale
+	IconResize
+	IconFilterPoint
+	IconFilterBilinear
+	IconCrop
+	IconCropAlpha
+	IconSquareToggle
+	IconSymmetry
+	IconSymmetryHorizontal
+	IconSymmetryVertical
+	IconLens
+	IconLensBig
+	IconEyeOn
+	IconEyeOff
+	IconFilterTop
+	IconFilter
+	IconTargetPoint
+	IconTargetSmall
+	IconTargetBig
+	IconTargetMove
+	IconCursorMove
+	IconCursorScale
+	IconCursorScaleRight
+	IconCursorScaleLeft
+	IconUndo
+	IconRedo
+	IconReredo
+	IconMutate
+	IconRotate
+	IconRepeat
+	IconShuffle
+	IconEmptybox
+	IconTarget
+	IconTargetSmallFill
+	IconTargetBigFill
+	IconTargetMoveFill
+	IconCursorMoveFill
+	IconCursorScaleFill
+	IconCursorScaleRightFill
+	IconCursorScaleLeftFill
+	IconUndoFill
+	IconRedoFill
+	IconReredoFill
+	IconMutateFill
+	IconRotateFill
+	IconRepeatFill
+	IconShuffleFill
+	IconEmptyboxSmall
+	IconBox
+	IconBoxTop
+	IconBoxTopRight
+	IconBoxRight
+	IconBoxBottomRight
+	IconBoxBottom
+	IconBoxBottomLeft
+	IconBoxLeft
+	IconBoxTopLeft
+	IconBoxCenter
+	IconBoxCircleMask
+	IconPot
+	IconAlphaMultiply
+	IconAlphaClear
+	IconDithering
+	IconMipmaps
+	IconBoxGrid
+	IconGrid
+	IconBoxCornersSmall
+	IconBoxCornersBig
+	IconFourBoxes
+	IconGridFill
+	IconBoxMultisize
+	IconZoomSmall
+	IconZoomMedium
+	IconZoomBig
+	IconZoomAll
+	IconZoomCenter
+	IconBoxDotsSmall
+	IconBoxDotsBig
+	IconBoxConcentric
+	IconBoxGridBig
+	IconOkTick
+	IconCross
+	IconArrowLeft
+	IconArrowRight
+	IconArrowBottom
+	IconArrowTop
+	IconArrowLeftFill
+	IconArrowRightFill
+	IconArrowBottomFill
+	IconArrowTopFill
+	IconAudio
+	IconFx
+	IconWave
+	IconWaveSinus
+	IconWaveSquare
+	IconWaveTriangular
+	IconCrossSmall
+	IconPlayerPrevious
+	IconPlayerPlayBack
+	IconPlayerPlay
+	IconPlayerPause
+	IconPlayerStop
+	IconPlayerNext
+	IconPlayerRecord
+	IconMagnet
+	IconLockClose
+	IconLockOpen
+	IconClock
+	IconTools
+	IconGear
+	IconGearBig
+	IconBin
+	IconHandPointer
+	IconLaser
+	IconCoin
+	IconExplosion
+	Icon1up
+	IconPlayer
+	IconPlayerJump
+	IconKey
+	IconDemon
+	IconTextPopup
+	IconGearEx
+	IconCrack
+	IconCrackPoints
+	IconStar
+	IconDoor
+	IconExit
+	IconMode2d
+	IconMode3d
+	IconCube
+	IconCubeFaceTop
+	IconCubeFaceLeft
+	IconCubeFaceFront
+	IconCubeFaceBottom
+	IconCubeFaceRight
+	IconCubeFaceBack
+	IconCamera
+	IconSpecial
+	IconLinkNet
+	IconLinkBoxes
+	IconLinkMulti
+	IconLink
+	IconLinkBroke
+	IconTextNotes
+	IconNotebook
+	IconSuitcase
+	IconSuitcaseZip
+	IconMailbox
+	IconMonitor
+	IconPrinter
+	IconPhotoCamera
+	IconPhotoCameraFlash
+	IconHouse
+	IconHeart
+	IconCorner
+	IconVerticalBars
+	IconVerticalBarsFill
+	IconLifeBars
+	IconInfo
+	IconCrossline
+	IconHelp
+	IconFiletypeAlpha
+	IconFiletypeHome
+	IconLayersVisible
+	IconLayers
+	IconWindow
+	IconHidpi
+	Icon200
+	Icon201
+	Icon202
+	Icon203
+	Icon204
+	Icon205
+	Icon206
+	Icon207
+	Icon208
+	Icon209
+	Icon210
+	Icon211
+	Icon212
+	Icon213
+	Icon214
+	Icon215
+	Icon216
+	Icon217
+	Icon218
+	Icon219
+	Icon220
+	Icon221
+	Icon222
+	Icon223
+	Icon224
+	Icon225
+	Icon226
+	Icon227
+	Icon228
+	Icon229
+	Icon230
+	Icon231
+	Icon232
+	Icon233
+	Icon234
+	Icon235
+	Icon236
+	Icon237
+	Icon238
+	Icon239
+	Icon240
+	Icon241
+	Icon242
+	Icon243
+	Icon244
+	Icon245
+	Icon246
+	Icon247
+	Icon248
+	Icon249
+	Icon250
+	Icon251
+	Icon252
+	Icon253
+	Icon254
+	Icon255
+)
+
 //----------------------------------------------------------------------------------
 // Icons data (allocated on memory data section by default)
-// NOTE: A new icon set could be loaded over this array using GuiLoadIcons(),
-// just note that loaded icons set must be same RICON_SIZE
+// NOTE: Every context starts with a copy of this array, a new icon set could be
+// loaded over the copy using LoadIcons(), just note that loaded icons set must be same RIconSize
 //----------------------------------------------------------------------------------
-var guiIcons = [RIconMaxIcons * RIconDataElements]uint32{
+var defaultIcons = [RIconMaxIcons * RIconDataElements]uint32{
 	0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, 0x00000000, // RICON_NONE
 	0x3ff80000, 0x2f082008, 0x2042207e, 0x40027fc2, 0x40024002, 0x40024002, 0x40024002, 0x00007ffe, // RICON_FOLDER_FILE_OPEN
 	0x3ffe0000, 0x44226422, 0x400247e2, 0x5ffa4002, 0x57ea500a, 0x500a500a, 0x40025ffa, 0x00007ffe, // RICON_FILE_SAVE_CLASSIC
@@ -264,9 +548,9 @@ var guiIcons = [RIconMaxIcons * RIconDataElements]uint32{
 	0x40080000, 0x1ffe2008, 0x14081008, 0x11081208, 0x10481088, 0x10081028, 0x10047ff8, 0x00001002, // RICON_CROP
 	0x00100000, 0x3ffc0010, 0x2ab03550, 0x22b02550, 0x20b02150, 0x20302050, 0x2000fff0, 0x00002000, // RICON_CROP_ALPHA
 	0x40000000, 0x1ff82000, 0x04082808, 0x01082208, 0x00482088, 0x00182028, 0x35542008, 0x00000002, // RICON_SQUARE_TOGGLE
-	0x00000000, 0x02800280, 0x06c006c0, 0x0ea00ee0, 0x1e901eb0, 0x3e883e98, 0x7efc7e8c, 0x00000000, // RICON_SIMMETRY
-	0x01000000, 0x05600100, 0x1d480d50, 0x7d423d44, 0x3d447d42, 0x0d501d48, 0x01000560, 0x00000100, // RICON_SIMMETRY_HORIZONTAL
-	0x01800000, 0x04200240, 0x10080810, 0x00001ff8, 0x00007ffe, 0x0ff01ff8, 0x03c007e0, 0x00000180, // RICON_SIMMETRY_VERTICAL
+	0x00000000, 0x02800280, 0x06c006c0, 0x0ea00ee0, 0x1e901eb0, 0x3e883e98, 0x7efc7e8c, 0x00000000, // RICON_SYMMETRY
+	0x01000000, 0x05600100, 0x1d480d50, 0x7d423d44, 0x3d447d42, 0x0d501d48, 0x01000560, 0x00000100, // RICON_SYMMETRY_HORIZONTAL
+	0x01800000, 0x04200240, 0x10080810, 0x00001ff8, 0x00007ffe, 0x0ff01ff8, 0x03c007e0, 0x00000180, // RICON_SYMMETRY_VERTICAL
 	0x00000000, 0x010800f0, 0x02040204, 0x02040204, 0x07f00308, 0x1c000e00, 0x30003800, 0x00000000, // RICON_LENS
 	0x00000000, 0x061803f0, 0x08240c0c, 0x08040814, 0x0c0c0804, 0x23f01618, 0x18002400, 0x00000000, // RICON_LENS_BIG
 	0x00000000, 0x00000000, 0x1c7007c0, 0x638e3398, 0x1c703398, 0x000007c0, 0x00000000, 0x00000000, // RICON_EYE_ON
@@ -295,8 +579,8 @@ var guiIcons = [RIconMaxIcons * RIconDataElements]uint32{
 	0x01000000, 0x07c00380, 0x01000100, 0x638c2008, 0x638cfbbe, 0x01002008, 0x07c00100, 0x01000380, // RICON_TARGET_MOVE_FILL
 	0x01000000, 0x07c00380, 0x01000100, 0x610c2108, 0x610cfffe, 0x01002108, 0x07c00100, 0x01000380, // RICON_CURSOR_MOVE_FILL
 	0x781e0000, 0x6006700e, 0x04204812, 0x00000240, 0x02400000, 0x48120420, 0x700e6006, 0x0000781e, // RICON_CURSOR_SCALE_FILL
-	0x00000000, 0x38003c00, 0x24003000, 0x01000200, 0x00400080, 0x000c0024, 0x003c001c, 0x00000000, // RICON_CURSOR_SCALE_RIGHT
-	0x00000000, 0x001c003c, 0x0024000c, 0x00800040, 0x02000100, 0x30002400, 0x3c003800, 0x00000000, // RICON_CURSOR_SCALE_LEFT
+	0x00000000, 0x38003c00, 0x24003000, 0x01000200, 0x00400080, 0x000c0024, 0x003c001c, 0x00000000, // RICON_CURSOR_SCALE_RIGHT_FILL
+	0x00000000, 0x001c003c, 0x0024000c, 0x00800040, 0x02000100, 0x30002400, 0x3c003800, 0x00000000, // RICON_CURSOR_SCALE_LEFT_FILL
 	0x00000000, 0x00300020, 0x10301ff8, 0x10001020, 0x10001000, 0x10001000, 0x00001fc0, 0x00000000, // RICON_UNDO_FILL
 	0x00000000, 0x0c000400, 0x0c081ff8, 0x00080408, 0x00080008, 0x00080008, 0x000003f8, 0x00000000, // RICON_REDO_FILL
 	0x00000000, 0x3ffc0000, 0x20042004, 0x20002000, 0x20402000, 0x3ff02060, 0x00400060, 0x00000000, // RICON_REREDO_FILL
@@ -314,8 +598,8 @@ var guiIcons = [RIconMaxIcons * RIconDataElements]uint32{
 	0x00000000, 0x20043ffc, 0x20042004, 0x20042004, 0x207c2004, 0x207c207c, 0x3ffc207c, 0x00000000, // RICON_BOX_BOTTOM_LEFT
 	0x00000000, 0x20043ffc, 0x20042004, 0x207c207c, 0x207c207c, 0x20042004, 0x3ffc2004, 0x00000000, // RICON_BOX_LEFT
 	0x00000000, 0x207c3ffc, 0x207c207c, 0x2004207c, 0x20042004, 0x20042004, 0x3ffc2004, 0x00000000, // RICON_BOX_TOP_LEFT
-	0x00000000, 0x20043ffc, 0x20042004, 0x23c423c4, 0x23c423c4, 0x20042004, 0x3ffc2004, 0x00000000, // RICON_BOX_CIRCLE_MASK
-	0x7ffe0000, 0x40024002, 0x47e24182, 0x4ff247e2, 0x47e24ff2, 0x418247e2, 0x40024002, 0x00007ffe, // RICON_BOX_CENTER
+	0x00000000, 0x20043ffc, 0x20042004, 0x23c423c4, 0x23c423c4, 0x20042004, 0x3ffc2004, 0x00000000, // RICON_BOX_CENTER
+	0x7ffe0000, 0x40024002, 0x47e24182, 0x4ff247e2, 0x47e24ff2, 0x418247e2, 0x40024002, 0x00007ffe, // RICON_BOX_CIRCLE_MASK
 	0x7fff0000, 0x40014001, 0x40014001, 0x49555ddd, 0x4945495d, 0x400149c5, 0x40014001, 0x00007fff, // RICON_POT
 	0x7ffe0000, 0x53327332, 0x44ce4cce, 0x41324332, 0x404e40ce, 0x48125432, 0x4006540e, 0x00007ffe, // RICON_ALPHA_MULTIPLY
 	0x7ffe0000, 0x53327332, 0x44ce4cce, 0x41324332, 0x5c4e40ce, 0x44124432, 0x40065c0e, 0x00007ffe, // RICON_ALPHA_CLEAR
@@ -487,89 +771,102 @@ var guiIcons = [RIconMaxIcons * RIconDataElements]uint32{
 // Gui Setup Functions Definition
 //----------------------------------------------------------------------------------
 
-// Enable gui global state
-func Enable() {
-	guiState = StateNormal
+// Begin gui frame, call it once every frame before drawing any control
+// NOTE(port): raygui.h has no frame boundary. Keyboard and gamepad focus move
+// along the controls drawn in previous frame, so focus requires this call,
+// ID-based controls not drawn in previous frame forget their retained state
+// (never dropped if it isn't called) and the indeterminate progress bar
+// segment moves once per call
+func (ctx *Context) BeginFrame() {
+	ctx.beginFocusFrame()
+	ctx.beginIDFrame()
+	ctx.marqueeTime = float32(math.Mod(float64(ctx.marqueeTime+ctx.backend.GetFrameTime()), ProgressBarMarqueePeriod))
+	ctx.frame++
+}
+
+// Enable gui context state
+func (ctx *Context) Enable() {
+	ctx.state = StateNormal
 }
 
-// Disable gui global state
-func Disable() {
-	guiState = StateDisabled
+// Disable gui context state
+func (ctx *Context) Disable() {
+	ctx.state = StateDisabled
 }
 
-// Lock gui global state
-func Lock() {
-	guiLocked = true
+// Lock gui context state
+func (ctx *Context) Lock() {
+	ctx.locked = true
 }
 
-// Unlock gui global state
-func Unlock() {
-	guiLocked = false
+// Unlock gui context state
+func (ctx *Context) Unlock() {
+	ctx.locked = false
 }
 
-// Set gui controls alpha global state
-func Fade(alpha float32) {
+// Set gui controls alpha context state
+func (ctx *Context) Fade(alpha float32) {
 	if alpha < 0 {
 		alpha = 0
 	} else if alpha > 1 {
 		alpha = 1
 	}
 
-	alpha = alpha
+	ctx.alpha = alpha
 }
 
-// Set gui state (global state)
-func SetState(state ControlState) {
-	guiState = state
+// Set gui state (context state)
+func (ctx *Context) SetState(state ControlState) {
+	ctx.state = state
 }
 
-// Get gui state (global state)
-func GetState() ControlState {
-	return guiState
+// Get gui state (context state)
+func (ctx *Context) GetState() ControlState {
+	return ctx.state
 }
 
 // Set custom gui font
 // NOTE: Font loading/unloading is external to raygui
-func SetFont(font rl.Font) {
+func (ctx *Context) SetFont(font rl.Font) {
 	if font.Texture.ID > 0 {
 		// NOTE: If we try to setup a font but default style has not been
 		// lazily loaded before, it will be overwritten, so we need to force
 		// default style loading first
-		if !guiStyleLoaded {
-			LoadStyleDefault()
+		if !ctx.styleLoaded {
+			ctx.LoadStyleDefault()
 		}
 
-		guiFont = font
-		SetStyle(Default, TextSizeProp, uint(font.BaseSize))
+		ctx.font = font
+		ctx.SetStyle(Default, TextSizeProp, uint(font.BaseSize))
 	}
 }
 
 // Get custom gui font
-func GetFont() rl.Font {
-	return guiFont
+func (ctx *Context) GetFont() rl.Font {
+	return ctx.font
 }
 
 // Set control style property value
-func SetStyle(control Control, property ControlProperty, value uint) {
-	if !guiStyleLoaded {
-		LoadStyleDefault()
+func (ctx *Context) SetStyle(control Control, property ControlProperty, value uint) {
+	if !ctx.styleLoaded {
+		ctx.LoadStyleDefault()
 	}
-	guiStyle[int(control)*(MaxPropsDefault+MaxPropsExtended)+int(property)] = value
+	ctx.style[int(control)*(MaxPropsDefault+MaxPropsExtended)+int(property)] = value
 
 	// Default properties are propagated to all controls
 	if (control == 0) && (property < MaxPropsDefault) {
 		for i := 1; i < MaxControls; i++ {
-			guiStyle[i*(MaxPropsDefault+MaxPropsExtended)+int(property)] = value
+			ctx.style[i*(MaxPropsDefault+MaxPropsExtended)+int(property)] = value
 		}
 	}
 }
 
 // Get control style property value
-func GetStyle(control Control, property ControlProperty) uint {
-	if !guiStyleLoaded {
-		LoadStyleDefault()
+func (ctx *Context) GetStyle(control Control, property ControlProperty) uint {
+	if !ctx.styleLoaded {
+		ctx.LoadStyleDefault()
 	}
-	return guiStyle[int(control)*(MaxPropsDefault+MaxPropsExtended)+int(property)]
+	return ctx.style[int(control)*(MaxPropsDefault+MaxPropsExtended)+int(property)]
 }
 
 //----------------------------------------------------------------------------------
@@ -580,11 +877,11 @@ func GetStyle(control Control, property ControlProperty) uint {
 const WindowStatusBarHeight = 22
 
 // Window Box control
-func WindowBox(bounds rl.Rectangle, title string) bool {
+func (ctx *Context) WindowBox(bounds rl.Rectangle, title string) bool {
 	//GuiControlState state = guiState;
 	clicked := false
 
-	statusBarHeight := WindowStatusBarHeight + 2*GetStyle(StatusBarControl, BorderWidthProp)
+	statusBarHeight := WindowStatusBarHeight + 2*ctx.GetStyle(StatusBarControl, BorderWidthProp)
 	statusBarHeight += statusBarHeight % 2
 
 	statusBar := rl.Rectangle{bounds.X, bounds.Y, bounds.Width, float32(statusBarHeight)}
@@ -594,7 +891,7 @@ func WindowBox(bounds rl.Rectangle, title string) bool {
 
 	windowPanel := rl.Rectangle{bounds.X, bounds.Y + float32(statusBarHeight) - 1, bounds.Width, bounds.Height - float32(statusBarHeight)}
 	closeButtonRec := rl.Rectangle{
-		statusBar.X + statusBar.Width - float32(GetStyle(StatusBarControl, BorderWidthProp)) - 20,
+		statusBar.X + statusBar.Width - float32(ctx.GetStyle(StatusBarControl, BorderWidthProp)) - 20,
 		statusBar.Y + float32(statusBarHeight)/2 - 18/2,
 		18, 18,
 	}
@@ -606,25 +903,17 @@ func WindowBox(bounds rl.Rectangle, title string) bool {
 
 	// Draw control
 	//--------------------------------------------------------------------
-	StatusBar(statusBar, title) // Draw window header as status bar
-	Panel(windowPanel)          // Draw window base
+	ctx.StatusBar(statusBar, title) // Draw window header as status bar
+	ctx.Panel(windowPanel)          // Draw window base
 
 	// Draw window close button
-	tempBorderWidth := GetStyle(ButtonControl, BorderWidthProp)
-	tempTextAlignment := GetStyle(ButtonControl, TextAlignmentProp)
-	SetStyle(ButtonControl, BorderWidthProp, 1)
-	SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
-	clicked = Button(closeButtonRec, "x")
-	/*
-		// TODO(icons)
-		#if defined(RAYGUI_SUPPORT_RICONS)
-			clicked = GuiButton(closeButtonRec, GuiIconText(RICON_CROSS_SMALL, NULL));
-		#else
-			clicked = GuiButton(closeButtonRec, "x");
-		#endif
-	*/
-	SetStyle(ButtonControl, BorderWidthProp, tempBorderWidth)
-	SetStyle(ButtonControl, TextAlignmentProp, tempTextAlignment)
+	tempBorderWidth := ctx.GetStyle(ButtonControl, BorderWidthProp)
+	tempTextAlignment := ctx.GetStyle(ButtonControl, TextAlignmentProp)
+	ctx.SetStyle(ButtonControl, BorderWidthProp, 1)
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
+	clicked = ctx.Button(closeButtonRec, IconText(IconCrossSmall, ""))
+	ctx.SetStyle(ButtonControl, BorderWidthProp, tempBorderWidth)
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, tempTextAlignment)
 
 	return clicked
 }
@@ -633,85 +922,85 @@ const GroupBoxLineThick = 1
 const GroupBoxTextPadding = 10
 
 // Group Box control with text name
-func GroupBox(bounds rl.Rectangle, text string) {
-	state := guiState
+func (ctx *Context) GroupBox(bounds rl.Rectangle, text string) {
+	state := ctx.state
 
 	borderColorProp := LineColorProp
 	if state == StateDisabled {
 		borderColorProp = BorderColorDisabledProp
 	}
-	borderColor := rl.GetColor(int32(GetStyle(Default, borderColorProp)))
+	borderColor := rl.GetColor(int32(ctx.GetStyle(Default, borderColorProp)))
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(rl.Rectangle{bounds.X, bounds.Y, GroupBoxLineThick, bounds.Height}, 0, rl.Blank, rl.Fade(borderColor, guiAlpha))
-	DrawRectangle(rl.Rectangle{bounds.X, bounds.Y + bounds.Height - 1, bounds.Width, GroupBoxLineThick}, 0, rl.Blank, rl.Fade(borderColor, guiAlpha))
-	DrawRectangle(rl.Rectangle{bounds.X + bounds.Width - 1, bounds.Y, GroupBoxLineThick, bounds.Height}, 0, rl.Blank, rl.Fade(borderColor, guiAlpha))
+	ctx.DrawRectangle(rl.Rectangle{bounds.X, bounds.Y, GroupBoxLineThick, bounds.Height}, 0, rl.Blank, rl.Fade(borderColor, ctx.alpha))
+	ctx.DrawRectangle(rl.Rectangle{bounds.X, bounds.Y + bounds.Height - 1, bounds.Width, GroupBoxLineThick}, 0, rl.Blank, rl.Fade(borderColor, ctx.alpha))
+	ctx.DrawRectangle(rl.Rectangle{bounds.X + bounds.Width - 1, bounds.Y, GroupBoxLineThick, bounds.Height}, 0, rl.Blank, rl.Fade(borderColor, ctx.alpha))
 
-	Line(rl.Rectangle{bounds.X, bounds.Y, bounds.Width, 1}, text)
+	ctx.Line(rl.Rectangle{bounds.X, bounds.Y, bounds.Width, 1}, text)
 	//--------------------------------------------------------------------
 }
 
 const LineTextPadding = 10
 
 // Line control
-func Line(bounds rl.Rectangle, text string) {
-	state := guiState
+func (ctx *Context) Line(bounds rl.Rectangle, text string) {
+	state := ctx.state
 
 	colorProp := LineColorProp
 	if state == StateDisabled {
 		colorProp = BorderColorDisabledProp
 	}
-	colorStyle := rl.GetColor(int32(GetStyle(Default, colorProp)))
+	colorStyle := rl.GetColor(int32(ctx.GetStyle(Default, colorProp)))
 
-	color := rl.Fade(colorStyle, guiAlpha)
+	color := rl.Fade(colorStyle, ctx.alpha)
 
 	// Draw control
 	//--------------------------------------------------------------------
 	textBounds := rl.Rectangle{
-		Width:  float32(GetTextWidth(text)), // TODO: Consider text icon
-		Height: float32(GetStyle(Default, TextSizeProp)),
+		Width:  float32(ctx.GetTextWidth(text)), // TODO: Consider text icon
+		Height: float32(ctx.GetStyle(Default, TextSizeProp)),
 		X:      bounds.X + LineTextPadding,
-		Y:      bounds.Y - float32(GetStyle(Default, TextSizeProp))/2,
+		Y:      bounds.Y - float32(ctx.GetStyle(Default, TextSizeProp))/2,
 	}
 
 	// Draw line with embedded text label: "--- text --------------"
-	DrawRectangle(rl.Rectangle{bounds.X, bounds.Y, LineTextPadding - 2, 1}, 0, rl.Blank, color)
-	Label(textBounds, text)
-	DrawRectangle(rl.Rectangle{bounds.X + LineTextPadding + textBounds.Width + 4, bounds.Y, bounds.Width - textBounds.Width - LineTextPadding - 4, 1}, 0, rl.Blank, color)
+	ctx.DrawRectangle(rl.Rectangle{bounds.X, bounds.Y, LineTextPadding - 2, 1}, 0, rl.Blank, color)
+	ctx.Label(textBounds, text)
+	ctx.DrawRectangle(rl.Rectangle{bounds.X + LineTextPadding + textBounds.Width + 4, bounds.Y, bounds.Width - textBounds.Width - LineTextPadding - 4, 1}, 0, rl.Blank, color)
 	//--------------------------------------------------------------------
 }
 
 const PanelBorderWidth = 1
 
 // Panel control
-func Panel(bounds rl.Rectangle) {
-	state := guiState
+func (ctx *Context) Panel(bounds rl.Rectangle) {
+	state := ctx.state
 
 	borderColorProp := LineColorProp
 	if state == StateDisabled {
 		borderColorProp = BorderColorDisabledProp
 	}
-	borderColor := rl.Fade(rl.GetColor(int32(GetStyle(Default, borderColorProp))), guiAlpha)
+	borderColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(Default, borderColorProp))), ctx.alpha)
 
 	colorProp := BackgroundColorProp
 	if state == StateDisabled {
 		colorProp = BaseColorDisabledProp
 	}
-	color := rl.Fade(rl.GetColor(int32(GetStyle(Default, colorProp))), guiAlpha)
+	color := rl.Fade(rl.GetColor(int32(ctx.GetStyle(Default, colorProp))), ctx.alpha)
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, PanelBorderWidth, borderColor, color)
+	ctx.DrawRectangle(bounds, PanelBorderWidth, borderColor, color)
 	//--------------------------------------------------------------------
 }
 
 // Scroll Panel control
-func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle {
-	state := guiState
+func (ctx *Context) ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle {
+	state := ctx.state
 
-	bw := float32(GetStyle(Default, BorderWidthProp))
-	side := ScrollBarSide(GetStyle(ListViewControl, ScrollBarSideProp))
+	bw := float32(ctx.GetStyle(Default, BorderWidthProp))
+	side := ScrollBarSide(ctx.GetStyle(ListViewControl, ScrollBarSideProp))
 
 	scrollPos := rl.Vector2{0, 0}
 	if scroll != nil {
@@ -723,19 +1012,19 @@ func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
 
 	// Recheck to account for the other scrollbar being visible
 	if !hasHorizontalScrollBar {
-		hasHorizontalScrollBar = hasVerticalScrollBar && (content.Width > bounds.Width-2*bw-float32(GetStyle(ListViewControl, ScrollBarWidth)))
+		hasHorizontalScrollBar = hasVerticalScrollBar && (content.Width > bounds.Width-2*bw-float32(ctx.GetStyle(ListViewControl, ScrollBarWidth)))
 	}
 	if !hasVerticalScrollBar {
-		hasVerticalScrollBar = hasHorizontalScrollBar && (content.Height > bounds.Height-2*bw-float32(GetStyle(ListViewControl, ScrollBarWidth)))
+		hasVerticalScrollBar = hasHorizontalScrollBar && (content.Height > bounds.Height-2*bw-float32(ctx.GetStyle(ListViewControl, ScrollBarWidth)))
 	}
 
 	var horizontalScrollBarWidth int = 0
 	if hasHorizontalScrollBar {
-		horizontalScrollBarWidth = int(GetStyle(ListViewControl, ScrollBarWidth))
+		horizontalScrollBarWidth = int(ctx.GetStyle(ListViewControl, ScrollBarWidth))
 	}
 	var verticalScrollBarWidth int = 0
 	if hasVerticalScrollBar {
-		verticalScrollBarWidth = int(GetStyle(ListViewControl, ScrollBarWidth))
+		verticalScrollBarWidth = int(ctx.GetStyle(ListViewControl, ScrollBarWidth))
 	}
 
 	hx := bounds.X
@@ -807,39 +1096,39 @@ func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		// Check button state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
 			} else {
 				state = StateFocused
 			}
 
 			if hasHorizontalScrollBar {
-				if rl.IsKeyDown(rl.KeyRight) {
-					scrollPos.X -= float32(GetStyle(ScrollBarControl, ScrollSpeed))
+				if ctx.backend.IsKeyDown(rl.KeyRight) {
+					scrollPos.X -= float32(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
 				}
-				if rl.IsKeyDown(rl.KeyLeft) {
-					scrollPos.X += float32(GetStyle(ScrollBarControl, ScrollSpeed))
+				if ctx.backend.IsKeyDown(rl.KeyLeft) {
+					scrollPos.X += float32(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
 				}
 			}
 
 			if hasVerticalScrollBar {
-				if rl.IsKeyDown(rl.KeyDown) {
-					scrollPos.Y -= float32(GetStyle(ScrollBarControl, ScrollSpeed))
+				if ctx.backend.IsKeyDown(rl.KeyDown) {
+					scrollPos.Y -= float32(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
 				}
-				if rl.IsKeyDown(rl.KeyUp) {
-					scrollPos.Y += float32(GetStyle(ScrollBarControl, ScrollSpeed))
+				if ctx.backend.IsKeyDown(rl.KeyUp) {
+					scrollPos.Y += float32(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
 				}
 			}
 
-			wheelMove := rl.GetMouseWheelMove()
+			wheelMove := ctx.backend.GetMouseWheelMove()
 
 			// Horizontal scroll (Shift + Mouse wheel)
-			if hasHorizontalScrollBar && (rl.IsKeyDown(rl.KeyLeftShift) || rl.IsKeyDown(rl.KeyRightShift)) {
+			if hasHorizontalScrollBar && (ctx.backend.IsKeyDown(rl.KeyLeftShift) || ctx.backend.IsKeyDown(rl.KeyRightShift)) {
 				scrollPos.X += float32(wheelMove) * 20
 			} else {
 				// Vertical scroll
@@ -865,23 +1154,23 @@ func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, 0, rl.Blank, rl.GetColor(int32(GetStyle(Default, BackgroundColorProp)))) // Draw background
+	ctx.DrawRectangle(bounds, 0, rl.Blank, rl.GetColor(int32(ctx.GetStyle(Default, BackgroundColorProp)))) // Draw background
 
 	// Save size of the scrollbar slider
-	slider := GetStyle(ScrollBarControl, ScrollSliderSize)
+	slider := ctx.GetStyle(ScrollBarControl, ScrollSliderSize)
 
 	// Draw horizontal scrollbar if visible
 	if hasHorizontalScrollBar {
 		// Change scrollbar slider size to show the diff in size between the content width and the widget width
-		SetStyle(ScrollBarControl, ScrollSliderSize, uint(((bounds.Width-2*bw-float32(verticalScrollBarWidth))/floor32(content.Width))*(floor32(bounds.Width)-2*bw-float32(verticalScrollBarWidth))))
-		scrollPos.X = float32(-ScrollBar(horizontalScrollBar, int(-scrollPos.X), int(horizontalMin), int(horizontalMax)))
+		ctx.SetStyle(ScrollBarControl, ScrollSliderSize, uint(((bounds.Width-2*bw-float32(verticalScrollBarWidth))/floor32(content.Width))*(floor32(bounds.Width)-2*bw-float32(verticalScrollBarWidth))))
+		scrollPos.X = float32(-ctx.ScrollBar(horizontalScrollBar, int(-scrollPos.X), int(horizontalMin), int(horizontalMax)))
 	}
 
 	// Draw vertical scrollbar if visible
 	if hasVerticalScrollBar {
 		// Change scrollbar slider size to show the diff in size between the content height and the widget height
-		SetStyle(ScrollBarControl, ScrollSliderSize, uint(((bounds.Height-2*bw-float32(horizontalScrollBarWidth))/floor32(content.Height))*(floor32(bounds.Height)-2*bw-float32(horizontalScrollBarWidth))))
-		scrollPos.Y = float32(-ScrollBar(verticalScrollBar, int(-scrollPos.Y), int(verticalMin), int(verticalMax)))
+		ctx.SetStyle(ScrollBarControl, ScrollSliderSize, uint(((bounds.Height-2*bw-float32(horizontalScrollBarWidth))/floor32(content.Height))*(floor32(bounds.Height)-2*bw-float32(horizontalScrollBarWidth))))
+		scrollPos.Y = float32(-ctx.ScrollBar(verticalScrollBar, int(-scrollPos.Y), int(verticalMin), int(verticalMax)))
 	}
 
 	// Draw detail corner rectangle if both scroll bars are visible
@@ -893,14 +1182,14 @@ func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
 			x = horizontalScrollBar.X + horizontalScrollBar.Width + 2
 		}
 		corner := rl.Rectangle{x, verticalScrollBar.Y + verticalScrollBar.Height + 2, float32(horizontalScrollBarWidth) - 4, float32(verticalScrollBarWidth) - 4}
-		DrawRectangle(corner, 0, rl.Blank, rl.Fade(rl.GetColor(int32(GetStyle(ListViewControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+		ctx.DrawRectangle(corner, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	}
 
 	// Draw scrollbar lines depending on current state
-	DrawRectangle(bounds, int(GetStyle(Default, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ListViewControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Blank)
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(Default, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Blank)
 
 	// Set scrollbar slider size back to the way it was before
-	SetStyle(ScrollBarControl, ScrollSliderSize, slider)
+	ctx.SetStyle(ScrollBarControl, ScrollSliderSize, slider)
 	//--------------------------------------------------------------------
 
 	if scroll != nil {
@@ -911,8 +1200,8 @@ func ScrollPanel(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
 }
 
 // Label control
-func Label(bounds rl.Rectangle, text string) {
-	state := guiState
+func (ctx *Context) Label(bounds rl.Rectangle, text string) {
+	state := ctx.state
 
 	// Update control
 	//--------------------------------------------------------------------
@@ -925,29 +1214,40 @@ func Label(bounds rl.Rectangle, text string) {
 	if state == StateDisabled {
 		colorProp = TextColorDisabledProp
 	}
-	DrawText(text, GetTextBounds(LabelControl, bounds), TextAlignment(GetStyle(LabelControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(LabelControl, colorProp))), guiAlpha))
+	ctx.DrawText(text, ctx.GetTextBounds(LabelControl, bounds), TextAlignment(ctx.GetStyle(LabelControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, colorProp))), ctx.alpha))
 	//--------------------------------------------------------------------
 }
 
 // Button control, returns true when clicked
-func Button(bounds rl.Rectangle, text string) bool {
-	state := guiState
+func (ctx *Context) Button(bounds rl.Rectangle, text string) bool {
+	state := ctx.state
 	pressed := false
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		// Check button state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
 			} else {
 				state = StateFocused
 			}
 
-			if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
+				pressed = true
+			}
+		}
+
+		// Check keyboard focus
+		if ctx.focusControl(ButtonControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
+
+			if ctx.focusActivated() {
 				pressed = true
 			}
 		}
@@ -956,38 +1256,38 @@ func Button(bounds rl.Rectangle, text string) bool {
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, int(GetStyle(ButtonControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Base+(ControlProperty(state)*3)))), guiAlpha))
-	DrawText(text, GetTextBounds(ButtonControl, bounds), TextAlignment(GetStyle(ButtonControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ButtonControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Base+(ControlProperty(state)*3)))), ctx.alpha))
+	ctx.DrawText(text, ctx.GetTextBounds(ButtonControl, bounds), TextAlignment(ctx.GetStyle(ButtonControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	//------------------------------------------------------------------
 
 	return pressed
 }
 
 // Label button control
-func LabelButton(bounds rl.Rectangle, text string) bool {
-	state := guiState
+func (ctx *Context) LabelButton(bounds rl.Rectangle, text string) bool {
+	state := ctx.state
 	pressed := false
 
 	// NOTE: We force bounds.width to be all text
-	textWidth := rl.MeasureTextEx(guiFont, text, float32(GetStyle(Default, TextSizeProp)), float32(GetStyle(Default, TextSpacingProp))).X
+	textWidth := ctx.backend.MeasureTextEx(ctx.font, text, float32(ctx.GetStyle(Default, TextSizeProp)), float32(ctx.GetStyle(Default, TextSpacingProp))).X
 	if bounds.Width < textWidth {
 		bounds.Width = textWidth
 	}
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		// Check button state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
 			} else {
 				state = StateFocused
 			}
 
-			if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
 				pressed = true
 			}
 		}
@@ -996,32 +1296,32 @@ func LabelButton(bounds rl.Rectangle, text string) bool {
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawText(text, GetTextBounds(LabelControl, bounds), TextAlignment(GetStyle(LabelControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawText(text, ctx.GetTextBounds(LabelControl, bounds), TextAlignment(ctx.GetStyle(LabelControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	//--------------------------------------------------------------------
 
 	return pressed
 }
 
 // Image button control, returns true when clicked
-func ImageButton(bounds rl.Rectangle, text string, texture rl.Texture2D) bool {
-	return ImageButtonEx(bounds, text, texture, rl.Rectangle{0, 0, float32(texture.Width), float32(texture.Height)})
+func (ctx *Context) ImageButton(bounds rl.Rectangle, text string, texture rl.Texture2D) bool {
+	return ctx.ImageButtonEx(bounds, text, texture, rl.Rectangle{0, 0, float32(texture.Width), float32(texture.Height)})
 }
 
 // Image button control, returns true when clicked
-func ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSource rl.Rectangle) bool {
-	state := guiState
+func (ctx *Context) ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSource rl.Rectangle) bool {
+	state := ctx.state
 	clicked := false
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		// Check button state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
-			} else if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+			} else if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
 				clicked = true
 			} else {
 				state = StateFocused
@@ -1032,11 +1332,11 @@ func ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSo
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, int(GetStyle(ButtonControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Base+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ButtonControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Base+(ControlProperty(state)*3)))), ctx.alpha))
 
-	DrawText(text, GetTextBounds(ButtonControl, bounds), TextAlignment(GetStyle(ButtonControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawText(text, ctx.GetTextBounds(ButtonControl, bounds), TextAlignment(ctx.GetStyle(ButtonControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	if texture.ID > 0 {
-		rl.DrawTextureRec(texture, texSource, rl.Vector2{bounds.X + bounds.Width/2 - texSource.Width/2, bounds.Y + bounds.Height/2 - texSource.Height/2}, rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+		ctx.backend.DrawTextureRec(texture, texSource, rl.Vector2{bounds.X + bounds.Width/2 - texSource.Width/2, bounds.Y + bounds.Height/2 - texSource.Height/2}, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	}
 	//------------------------------------------------------------------
 
@@ -1044,25 +1344,36 @@ func ImageButtonEx(bounds rl.Rectangle, text string, texture rl.Texture2D, texSo
 }
 
 // Toggle Button control, returns true when active
-func Toggle(bounds rl.Rectangle, text string, active bool) bool {
-	state := guiState
+func (ctx *Context) Toggle(bounds rl.Rectangle, text string, active bool) bool {
+	state := ctx.state
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		// Check toggle button state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
-			} else if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+			} else if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
 				state = StateNormal
 				active = !active
 			} else {
 				state = StateFocused
 			}
 		}
+
+		// Check keyboard focus
+		if ctx.focusControl(ToggleControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
+
+			if ctx.focusActivated() {
+				active = !active
+			}
+		}
 	}
 	//--------------------------------------------------------------------
 
@@ -1077,13 +1388,13 @@ func Toggle(bounds rl.Rectangle, text string, active bool) bool {
 		} else {
 			borderColorProp = Border + ControlProperty(state)*3
 			baseColorProp = Base + ControlProperty(state)*3
-			textColorProp = Base + ControlProperty(state)*3
+			textColorProp = Text + ControlProperty(state)*3
 		}
-		DrawRectangle(bounds, int(GetStyle(ToggleControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, borderColorProp))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, baseColorProp))), guiAlpha))
-		DrawText(text, GetTextBounds(ToggleControl, bounds), TextAlignment(GetStyle(ToggleControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, textColorProp))), guiAlpha))
+		ctx.DrawRectangle(bounds, int(ctx.GetStyle(ToggleControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, borderColorProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, baseColorProp))), ctx.alpha))
+		ctx.DrawText(text, ctx.GetTextBounds(ToggleControl, bounds), TextAlignment(ctx.GetStyle(ToggleControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, textColorProp))), ctx.alpha))
 	} else {
-		DrawRectangle(bounds, int(GetStyle(ToggleControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, Border+ControlProperty(state)*3))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, Base+ControlProperty(state)*3))), guiAlpha))
-		DrawText(text, GetTextBounds(ToggleControl, bounds), TextAlignment(GetStyle(ToggleControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(ToggleControl, Text+ControlProperty(state)*3))), guiAlpha))
+		ctx.DrawRectangle(bounds, int(ctx.GetStyle(ToggleControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, Base+ControlProperty(state)*3))), ctx.alpha))
+		ctx.DrawText(text, ctx.GetTextBounds(ToggleControl, bounds), TextAlignment(ctx.GetStyle(ToggleControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ToggleControl, Text+ControlProperty(state)*3))), ctx.alpha))
 	}
 	//--------------------------------------------------------------------
 
@@ -1093,74 +1404,85 @@ func Toggle(bounds rl.Rectangle, text string, active bool) bool {
 const ToggleGroupMaxElements = 32
 
 // Toggle Group control, returns toggled button index
-func ToggleGroup(bounds rl.Rectangle, text string, active int) int {
+func (ctx *Context) ToggleGroup(bounds rl.Rectangle, text string, active int) int {
 	initBoundsX := bounds.X
 
 	// Get substrings items from text (items pointers)
 	var rows [ToggleGroupMaxElements]int
 	itemCount := 0
-	items := TextSplit(text, &itemCount, rows[:])
+	items := ctx.TextSplit(text, &itemCount, rows[:])
 
 	prevRow := rows[0]
 
 	for i := 0; i < itemCount; i++ {
 		if prevRow != rows[i] {
 			bounds.X = initBoundsX
-			bounds.Y += bounds.Height + float32(GetStyle(ToggleControl, GroupPadding))
+			bounds.Y += bounds.Height + float32(ctx.GetStyle(ToggleControl, GroupPadding))
 			prevRow = rows[i]
 		}
 
 		if i == active {
-			Toggle(bounds, items[i], true)
-		} else if Toggle(bounds, items[i], false) {
+			ctx.Toggle(bounds, items[i], true)
+		} else if ctx.Toggle(bounds, items[i], false) {
 			active = i
 		}
 
-		bounds.X += bounds.Width + float32(GetStyle(ToggleControl, GroupPadding))
+		bounds.X += bounds.Width + float32(ctx.GetStyle(ToggleControl, GroupPadding))
 	}
 
 	return active
 }
 
 // Check Box control, returns true when active
-func CheckBox(bounds rl.Rectangle, text string, checked bool) bool {
-	state := guiState
+func (ctx *Context) CheckBox(bounds rl.Rectangle, text string, checked bool) bool {
+	state := ctx.state
 
 	textBounds := rl.Rectangle{
-		Width:  float32(GetTextWidth(text)),
-		Height: float32(GetStyle(Default, TextSizeProp)),
-		X:      bounds.X + bounds.Width + float32(GetStyle(CheckBoxControl, TextPaddingProp)),
-		Y:      bounds.Y + bounds.Height/2 - float32(GetStyle(Default, TextSizeProp)/2),
+		Width:  float32(ctx.GetTextWidth(text)),
+		Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+		X:      bounds.X + bounds.Width + float32(ctx.GetStyle(CheckBoxControl, TextPaddingProp)),
+		Y:      bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2),
 	}
-	if TextAlignment(GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignLeft {
-		textBounds.X = bounds.X - textBounds.Width - float32(GetStyle(CheckBoxControl, TextPaddingProp))
+	if TextAlignment(ctx.GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignLeft {
+		textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(CheckBoxControl, TextPaddingProp))
 	}
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		x := bounds.X
-		if TextAlignment(GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignLeft {
+		if TextAlignment(ctx.GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignLeft {
 			x = textBounds.X
 		}
 		totalBounds := rl.Rectangle{
 			X:      x,
 			Y:      bounds.Y,
-			Width:  bounds.Width + textBounds.Width + float32(GetStyle(CheckBoxControl, TextPaddingProp)),
+			Width:  bounds.Width + textBounds.Width + float32(ctx.GetStyle(CheckBoxControl, TextPaddingProp)),
 			Height: bounds.Height,
 		}
 
 		// Check checkbox state
 		if rl.CheckCollisionPointRec(mousePoint, totalBounds) {
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
 			} else {
 				state = StateFocused
 			}
 
-			if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
+				checked = !checked
+			}
+		}
+
+		// Check keyboard focus
+		if ctx.focusControl(CheckBoxControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
+
+			if ctx.focusActivated() {
 				checked = !checked
 			}
 		}
@@ -1169,46 +1491,46 @@ func CheckBox(bounds rl.Rectangle, text string, checked bool) bool {
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, int(GetStyle(CheckBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(CheckBoxControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Blank)
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(CheckBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(CheckBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Blank)
 
 	if checked {
 		check := rl.Rectangle{
-			X:      bounds.X + float32(GetStyle(CheckBoxControl, BorderWidthProp)) + float32(GetStyle(CheckBoxControl, CheckPadding)),
-			Y:      bounds.Y + float32(GetStyle(CheckBoxControl, BorderWidthProp)) + float32(GetStyle(CheckBoxControl, CheckPadding)),
-			Width:  bounds.Width - 2*(float32(GetStyle(CheckBoxControl, BorderWidthProp))+float32(GetStyle(CheckBoxControl, CheckPadding))),
-			Height: bounds.Height - 2*(float32(GetStyle(CheckBoxControl, BorderWidthProp))+float32(GetStyle(CheckBoxControl, CheckPadding))),
+			X:      bounds.X + float32(ctx.GetStyle(CheckBoxControl, BorderWidthProp)) + float32(ctx.GetStyle(CheckBoxControl, CheckPadding)),
+			Y:      bounds.Y + float32(ctx.GetStyle(CheckBoxControl, BorderWidthProp)) + float32(ctx.GetStyle(CheckBoxControl, CheckPadding)),
+			Width:  bounds.Width - 2*(float32(ctx.GetStyle(CheckBoxControl, BorderWidthProp))+float32(ctx.GetStyle(CheckBoxControl, CheckPadding))),
+			Height: bounds.Height - 2*(float32(ctx.GetStyle(CheckBoxControl, BorderWidthProp))+float32(ctx.GetStyle(CheckBoxControl, CheckPadding))),
 		}
-		DrawRectangle(check, 0, rl.Blank, rl.Fade(rl.GetColor(int32(GetStyle(CheckBoxControl, Text+ControlProperty(state)*3))), guiAlpha))
+		ctx.DrawRectangle(check, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(CheckBoxControl, Text+ControlProperty(state)*3))), ctx.alpha))
 	}
 
 	var align TextAlignment
-	if TextAlignment(GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignRight {
+	if TextAlignment(ctx.GetStyle(CheckBoxControl, TextAlignmentProp)) == TextAlignRight {
 		align = TextAlignLeft
 	} else {
 		align = TextAlignRight
 	}
-	DrawText(text, textBounds, align, rl.Fade(rl.GetColor(int32(GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawText(text, textBounds, align, rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 	//--------------------------------------------------------------------
 
 	return checked
 }
 
 // Combo Box control, returns selected item index
-func ComboBox(bounds rl.Rectangle, text string, active int) int {
-	state := guiState
+func (ctx *Context) ComboBox(bounds rl.Rectangle, text string, active int) int {
+	state := ctx.state
 
-	bounds.Width -= float32(GetStyle(ComboBoxControl, ComboButtonWidth)) + float32(GetStyle(ComboBoxControl, ComboButtonPadding))
+	bounds.Width -= float32(ctx.GetStyle(ComboBoxControl, ComboButtonWidth)) + float32(ctx.GetStyle(ComboBoxControl, ComboButtonPadding))
 
 	selector := rl.Rectangle{
-		X:      bounds.X + bounds.Width + float32(GetStyle(ComboBoxControl, ComboButtonPadding)),
+		X:      bounds.X + bounds.Width + float32(ctx.GetStyle(ComboBoxControl, ComboButtonPadding)),
 		Y:      bounds.Y,
-		Width:  float32(GetStyle(ComboBoxControl, ComboButtonWidth)),
+		Width:  float32(ctx.GetStyle(ComboBoxControl, ComboButtonWidth)),
 		Height: bounds.Height,
 	}
 
 	// Get substrings items from text (items pointers, lengths and count)
 	itemCount := 0
-	items := TextSplit(text, &itemCount, nil)
+	items := ctx.TextSplit(text, &itemCount, nil)
 
 	if active < 0 {
 		active = 0
@@ -1218,43 +1540,59 @@ func ComboBox(bounds rl.Rectangle, text string, active int) int {
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked && itemCount > 1 {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked && itemCount > 1 {
+		mousePoint := ctx.backend.GetMousePosition()
 
 		if rl.CheckCollisionPointRec(mousePoint, bounds) || rl.CheckCollisionPointRec(mousePoint, selector) {
-			if rl.IsMouseButtonPressed(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
 				active += 1
 				if active >= itemCount {
 					active = 0
 				}
 			}
 
-			if rl.IsMouseButtonDown(rl.MouseLeftButton) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
 				state = StatePressed
 			} else {
 				state = StateFocused
 			}
 		}
+
+		// Check keyboard focus, activating selects next item
+		if ctx.focusControl(ComboBoxControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
+
+			step := ctx.focusStep()
+			if ctx.focusActivated() {
+				step = 1
+			}
+			active = (active + step + itemCount) % itemCount
+		}
 	}
 	//--------------------------------------------------------------------
 
 	// Draw control
 	//--------------------------------------------------------------------
 	// Draw combo box main
-	DrawRectangle(bounds, int(GetStyle(ComboBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ComboBoxControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(ComboBoxControl, Base+(ControlProperty(state)*3)))), guiAlpha))
-	DrawText(items[active], GetTextBounds(ComboBoxControl, bounds), TextAlignment(GetStyle(ComboBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(ComboBoxControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ComboBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ComboBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ComboBoxControl, Base+(ControlProperty(state)*3)))), ctx.alpha))
+	ctx.DrawText(items[active], ctx.GetTextBounds(ComboBoxControl, bounds), TextAlignment(ctx.GetStyle(ComboBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ComboBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
 
 	// Draw selector using a custom button
 	// NOTE: BORDER_WIDTH and TEXT_ALIGNMENT forced values
-	tempBorderWidth := GetStyle(ButtonControl, BorderWidthProp)
-	tempTextAlign := GetStyle(ButtonControl, TextAlignmentProp)
-	SetStyle(ButtonControl, BorderWidthProp, 1)
-	SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
-
-	Button(selector, fmt.Sprintf("%d/%d", active+1, itemCount))
-
-	SetStyle(ButtonControl, TextAlignmentProp, tempTextAlign)
-	SetStyle(ButtonControl, BorderWidthProp, tempBorderWidth)
+	tempBorderWidth := ctx.GetStyle(ButtonControl, BorderWidthProp)
+	tempTextAlign := ctx.GetStyle(ButtonControl, TextAlignmentProp)
+	ctx.SetStyle(ButtonControl, BorderWidthProp, 1)
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
+
+	// NOTE(port): Selector button is part of the combo box, it can't get keyboard focus
+	ctx.focusDisabled = true
+	ctx.Button(selector, fmt.Sprintf("%d/%d", active+1, itemCount))
+	ctx.focusDisabled = false
+
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, tempTextAlign)
+	ctx.SetStyle(ButtonControl, BorderWidthProp, tempBorderWidth)
 	//--------------------------------------------------------------------
 
 	return active
@@ -1262,17 +1600,23 @@ func ComboBox(bounds rl.Rectangle, text string, active int) int {
 
 // Dropdown Box control
 // NOTE: Returns mouse click
-func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) bool {
-	state := guiState
+func (ctx *Context) DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) bool {
+	state := ctx.state
 	itemSelected := *active
 	itemFocused := -1
 
 	// Get substrings items from text (items pointers, lengths and count)
 	itemCount := 0
-	items := TextSplit(text, &itemCount, nil)
+	items := ctx.TextSplit(text, &itemCount, nil)
+
+	if itemSelected < 0 {
+		itemSelected = 0
+	} else if itemSelected > itemCount-1 {
+		itemSelected = itemCount - 1
+	}
 
 	boundsOpen := bounds
-	boundsOpen.Height = float32(itemCount+1) * (bounds.Height + float32(GetStyle(DropdownBoxControl, DropdownItemsPadding)))
+	boundsOpen.Height = float32(itemCount+1) * (bounds.Height + float32(ctx.GetStyle(DropdownBoxControl, DropdownItemsPadding)))
 
 	itemBounds := bounds
 
@@ -1280,32 +1624,38 @@ func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) b
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked && itemCount > 1 {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked && itemCount > 1 {
+		mousePoint := ctx.backend.GetMousePosition()
+		focused := ctx.focusControl(DropdownBoxControl, bounds)
 
 		if editMode {
 			state = StatePressed
 
+			// Keep active item as it was when the dropdown was opened
+			if ctx.dropdownBounds != bounds {
+				ctx.dropdownBounds, ctx.dropdownActive = bounds, itemSelected
+			}
+
 			// Check if mouse has been pressed or released outside limits
 			if !rl.CheckCollisionPointRec(mousePoint, boundsOpen) {
-				if rl.IsMouseButtonPressed(rl.MouseLeftButton) || rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) || ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
 					pressed = true
 				}
 			}
 
 			// Check if already selected item has been pressed again
-			if rl.CheckCollisionPointRec(mousePoint, bounds) && rl.IsMouseButtonPressed(rl.MouseLeftButton) {
+			if rl.CheckCollisionPointRec(mousePoint, bounds) && ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
 				pressed = true
 			}
 
 			// Check focused and selected item
 			for i := 0; i < itemCount; i++ {
 				// Update item rectangle y position for next item
-				itemBounds.Y += bounds.Height + float32(GetStyle(DropdownBoxControl, DropdownItemsPadding))
+				itemBounds.Y += bounds.Height + float32(ctx.GetStyle(DropdownBoxControl, DropdownItemsPadding))
 
 				if rl.CheckCollisionPointRec(mousePoint, itemBounds) {
 					itemFocused = i
-					if rl.IsMouseButtonReleased(rl.MouseLeftButton) {
+					if ctx.backend.IsMouseButtonReleased(rl.MouseLeftButton) {
 						itemSelected = i
 						pressed = true // Item selected, change to editMode = false
 					}
@@ -1314,15 +1664,48 @@ func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) b
 			}
 
 			itemBounds = bounds
+
+			// Check keyboard and gamepad input, open dropdown keeps keyboard focus until closed,
+			// gamepad B closes it restoring the item active when it was opened
+			if ctx.focusCancelled() {
+				itemSelected = ctx.dropdownActive
+				pressed = true
+			} else if ctx.focusTabbed() || ctx.focusActivated() {
+				pressed = true
+			} else {
+				itemSelected = focusClamp(itemSelected+ctx.focusStep()+ctx.gamepadStep(), itemCount)
+			}
+
+			if !pressed {
+				ctx.setFocus(DropdownBoxControl, bounds)
+			} else {
+				ctx.dropdownBounds = rl.Rectangle{}
+			}
 		} else {
+			if ctx.dropdownBounds == bounds {
+				ctx.dropdownBounds = rl.Rectangle{}
+			}
+
 			if rl.CheckCollisionPointRec(mousePoint, bounds) {
-				if rl.IsMouseButtonPressed(rl.MouseLeftButton) {
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
 					pressed = true
 					state = StatePressed
 				} else {
 					state = StateFocused
 				}
 			}
+
+			// Check keyboard focus
+			if focused {
+				if state == StateNormal {
+					state = StateFocused
+				}
+
+				if ctx.focusActivated() {
+					pressed = true
+				}
+				itemSelected = focusClamp(itemSelected+ctx.focusStep(), itemCount)
+			}
 		}
 	}
 	//--------------------------------------------------------------------
@@ -1330,36 +1713,36 @@ func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) b
 	// Draw control
 	//--------------------------------------------------------------------
 	if editMode {
-		Panel(boundsOpen)
+		ctx.Panel(boundsOpen)
 	}
 
-	DrawRectangle(bounds, int(GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, Border+ControlProperty(state)*3))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, Base+ControlProperty(state)*3))), guiAlpha))
-	DrawText(items[itemSelected], GetTextBounds(Default, bounds), TextAlignment(GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, Text+ControlProperty(state)*3))), guiAlpha))
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, Base+ControlProperty(state)*3))), ctx.alpha))
+	ctx.DrawText(items[itemSelected], ctx.GetTextBounds(Default, bounds), TextAlignment(ctx.GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, Text+ControlProperty(state)*3))), ctx.alpha))
 
 	if editMode {
 		// Draw visible items
 		for i := 0; i < itemCount; i++ {
 			// Update item rectangle y position for next item
-			itemBounds.Y += bounds.Height + float32(GetStyle(DropdownBoxControl, DropdownItemsPadding))
+			itemBounds.Y += bounds.Height + float32(ctx.GetStyle(DropdownBoxControl, DropdownItemsPadding))
 
 			if i == itemSelected {
-				DrawRectangle(itemBounds, int(GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, BorderColorPressedProp))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, BaseColorPressedProp))), guiAlpha))
-				DrawText(items[i], GetTextBounds(Default, itemBounds), TextAlignment(GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, TextColorPressedProp))), guiAlpha))
+				ctx.DrawRectangle(itemBounds, int(ctx.GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, BorderColorPressedProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, BaseColorPressedProp))), ctx.alpha))
+				ctx.DrawText(items[i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, TextColorPressedProp))), ctx.alpha))
 			} else if i == itemFocused {
-				DrawRectangle(itemBounds, int(GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, BorderColorFocusedProp))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, BaseColorFocusedProp))), guiAlpha))
-				DrawText(items[i], GetTextBounds(Default, itemBounds), TextAlignment(GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, TextColorFocusedProp))), guiAlpha))
+				ctx.DrawRectangle(itemBounds, int(ctx.GetStyle(DropdownBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, BorderColorFocusedProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, BaseColorFocusedProp))), ctx.alpha))
+				ctx.DrawText(items[i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, TextColorFocusedProp))), ctx.alpha))
 			} else {
-				DrawText(items[i], GetTextBounds(Default, itemBounds), TextAlignment(GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, TextColorNormalProp))), guiAlpha))
+				ctx.DrawText(items[i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(DropdownBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, TextColorNormalProp))), ctx.alpha))
 			}
 		}
 	}
 
 	// TODO: Avoid this function, use icon instead or 'v'
-	rl.DrawTriangle(
-		rl.Vector2{bounds.X + bounds.Width - float32(GetStyle(DropdownBoxControl, ArrowPadding)), bounds.Y + bounds.Height/2 - 2},
-		rl.Vector2{bounds.X + bounds.Width - float32(GetStyle(DropdownBoxControl, ArrowPadding)) + 5, bounds.Y + bounds.Height/2 - 2 + 5},
-		rl.Vector2{bounds.X + bounds.Width - float32(GetStyle(DropdownBoxControl, ArrowPadding)) + 10, bounds.Y + bounds.Height/2 - 2},
-		rl.Fade(rl.GetColor(int32(GetStyle(DropdownBoxControl, Text+(ControlProperty(state)*3)))), guiAlpha),
+	ctx.backend.DrawTriangle(
+		rl.Vector2{bounds.X + bounds.Width - float32(ctx.GetStyle(DropdownBoxControl, ArrowPadding)), bounds.Y + bounds.Height/2 - 2},
+		rl.Vector2{bounds.X + bounds.Width - float32(ctx.GetStyle(DropdownBoxControl, ArrowPadding)) + 5, bounds.Y + bounds.Height/2 - 2 + 5},
+		rl.Vector2{bounds.X + bounds.Width - float32(ctx.GetStyle(DropdownBoxControl, ArrowPadding)) + 10, bounds.Y + bounds.Height/2 - 2},
+		rl.Fade(rl.GetColor(int32(ctx.GetStyle(DropdownBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha),
 	)
 
 	//GuiDrawText("v", RAYGUI_CLITERAL(Rectangle){ bounds.x + bounds.width - GuiGetStyle(DROPDOWNBOX, ARROW_PADDING), bounds.y + bounds.height/2 - 2, 10, 10 },
@@ -1374,370 +1757,2515 @@ func DropdownBox(bounds rl.Rectangle, text string, active *int, editMode bool) b
 // NOTE 2: Returns if KEY_ENTER pressed (useful for data validation)
 //
 // NOTE(port): The signature of this method is different because of differences
-// in how strings work between C and Go.
-func TextBox(bounds rl.Rectangle, text string, textSize int, editMode bool) (string, bool) {
-	state := guiState
+// in how strings work between C and Go. textSize limits the number of characters
+// instead of bytes, so multi-byte characters don't use up more of it.
+func (ctx *Context) TextBox(bounds rl.Rectangle, text string, textSize int, editMode bool) (string, bool) {
+	// NOTE: textSize includes the null terminator of raygui.h text buffers
+	options := TextBoxOptions{MaxRunes: textSize - 1}
+	if options.MaxRunes <= 0 {
+		options.MaxRunes = -1 // No room for any character
+	}
+
+	return ctx.TextBoxPro(bounds, text, options, editMode)
+}
+
+// Text Box control with input options: filter, character limit, password mask and placeholder
+// NOTE: TextBox() uses this one
+// While editing, the cursor is moved with the arrow keys, Home, End and mouse
+// clicks, Shift selects text and Ctrl+C/X/V use the clipboard (see textEditKeys()),
+// Ctrl+Z and Ctrl+Y undo and redo edits (see undoKeys())
+func (ctx *Context) TextBoxPro(bounds rl.Rectangle, text string, options TextBoxOptions, editMode bool) (string, bool) {
+	state := ctx.state
 	pressed := false
 
-	cursor := rl.Rectangle{
-		X:      bounds.X + float32(GetStyle(TextBoxControl, TextPaddingProp)) + float32(GetTextWidth(text)) + 2,
-		Y:      bounds.Y + bounds.Height/2 - float32(GetStyle(Default, TextSizeProp)),
-		Width:  4,
-		Height: float32(GetStyle(Default, TextSizeProp)) * 2,
-	}
+	var edit *textEdit // Cursor and selection, only while editing
 
 	// Update control
 	//--------------------------------------------------------------------
-	if state != StateDisabled && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+		focused := ctx.focusControl(TextBoxControl, bounds)
 
 		if editMode {
 			state = StatePressed
 
-			key := rl.GetCharPressed() // Returns codepoint as Unicode
-			keyCount := len(text)
+			edit = ctx.textEditState(bounds, text)
+			maxWidth := bounds.Width - float32(ctx.GetStyle(TextBoxControl, TextInnerPadding)*2)
+			before := UndoState{Text: text, Cursor: edit.cursor}
 
-			// Only allow keys in range [32..125]
-			if keyCount < (textSize - 1) {
-				maxWidth := bounds.Width - float32(GetStyle(TextBoxControl, TextInnerPadding)*2)
-
-				if float32(GetTextWidth(text)) < maxWidth-float32(GetStyle(Default, TextSizeProp)) && (key >= 32) {
-					byteSize := 0
-					textUTF8 := CodepointToUTF8(key, &byteSize)
-					text = text + textUTF8
-					keyCount += byteSize
+			// Place cursor with mouse, dragging selects text and Shift+click extends selection
+			if rl.CheckCollisionPointRec(mousePoint, bounds) && ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				edit.cursor = options.textOffset(text, ctx.textBoxOffsetAt(bounds, options.shown(text), mousePoint.X))
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) && !ctx.backend.IsKeyDown(rl.KeyLeftShift) && !ctx.backend.IsKeyDown(rl.KeyRightShift) {
+					edit.anchor = edit.cursor
 				}
 			}
 
-			// Delete text
-			if keyCount > 0 {
-				if rl.IsKeyPressed(rl.KeyBackspace) {
-					keyCount--
-					text = text[:len(text)-1]
-					if keyCount < 0 {
-						keyCount = 0
-					}
+			if undone, ok := ctx.undoKeys(TextBoxControl, bounds, UndoState{Text: text, Cursor: edit.cursor}); ok {
+				text = undone.Text
+				edit.cursor = textClampOffset(text, undone.Cursor)
+				edit.anchor = edit.cursor
+			} else {
+				// Move cursor, select, delete and use clipboard
+				text = ctx.textEditKeys(edit, text, &options, maxWidth)
+				edited := text != before.Text
+				typedAt := edit.cursor
+
+				// Insert typed character, replacing selected text
+				key := ctx.backend.GetCharPressed() // Returns codepoint as Unicode
+				if key >= 32 && !ctx.backend.IsKeyDown(rl.KeyLeftControl) && !ctx.backend.IsKeyDown(rl.KeyRightControl) {
+					text = ctx.textEditInsert(edit, text, string(rune(key)), &options, maxWidth)
+				}
+
+				// Record edit for undo, typing without moving the cursor merges into last step
+				if text != before.Text {
+					ctx.undoRecord(TextBoxControl, bounds, before, UndoState{Text: text, Cursor: edit.cursor}, !edited && typedAt == before.Cursor)
+				} else if edit.cursor != before.Cursor {
+					ctx.undoBreak(TextBoxControl, bounds)
 				}
 			}
 
-			if rl.IsKeyPressed(rl.KeyEnter) || (!rl.CheckCollisionPointRec(mousePoint, bounds) && rl.IsMouseButtonPressed(rl.MouseLeftButton)) {
+			if ctx.backend.IsKeyPressed(rl.KeyEnter) || (!rl.CheckCollisionPointRec(mousePoint, bounds) && ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton)) {
 				pressed = true
 			}
 
-			// Check text alignment to position cursor properly
-			textAlignment := TextAlignment(GetStyle(TextBoxControl, TextAlignmentProp))
-			if textAlignment == TextAlignCenter {
-				cursor.X = bounds.X + float32(GetTextWidth(text)/2) + bounds.Width/2 + 1
-			} else if textAlignment == TextAlignRight {
-				cursor.X = bounds.X + bounds.Width - float32(GetStyle(TextBoxControl, TextInnerPadding))
+			// Text box in edit mode keeps keyboard focus, Tab ends edit mode moving focus,
+			// gamepad A ends edit mode too and B ends it restoring the text it started with
+			if ctx.focusCancelled() {
+				text = edit.original
+				edit.cursor = textClampOffset(text, edit.cursor)
+				edit.anchor = edit.cursor
+				pressed = true
+			} else if ctx.focusTabbed() || ctx.gamepadPressed(gamepadButtonRightFaceDown) {
+				pressed = true
+			} else if !pressed {
+				ctx.setFocus(TextBoxControl, bounds)
 			}
 		} else {
+			ctx.textEditEnd(bounds)
+
 			if rl.CheckCollisionPointRec(mousePoint, bounds) {
 				state = StateFocused
-				if rl.IsMouseButtonPressed(rl.MouseLeftButton) {
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+					pressed = true
+
+					// Clicking to start edit mode places the cursor
+					ctx.textEditStart(bounds, text, options.textOffset(text, ctx.textBoxOffsetAt(bounds, options.shown(text), mousePoint.X)))
+				}
+			}
+
+			// Check keyboard focus
+			if focused {
+				state = StateFocused
+				if ctx.focusActivated() {
 					pressed = true
 				}
 			}
 		}
 	}
+
+	// Password text is drawn masked, cursor and selection are placed in it
+	shown := options.shown(text)
+	var shownEdit textEdit
+	if edit != nil {
+		shownEdit = *edit
+		shownEdit.cursor = options.shownOffset(text, edit.cursor)
+		shownEdit.anchor = options.shownOffset(text, edit.anchor)
+	}
+
+	// NOTE(port): Cursor is placed after this frame edits at the cursor position,
+	// raygui.h places it at the end of the text before them
+	cursor := rl.Rectangle{
+		X:      bounds.X + float32(ctx.GetStyle(TextBoxControl, TextPaddingProp)) + float32(ctx.GetTextWidth(shown)) + 2,
+		Y:      bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)),
+		Width:  4,
+		Height: float32(ctx.GetStyle(Default, TextSizeProp)) * 2,
+	}
+
+	// Check text alignment to position cursor properly
+	textAlignment := TextAlignment(ctx.GetStyle(TextBoxControl, TextAlignmentProp))
+	if textAlignment == TextAlignCenter {
+		cursor.X = bounds.X + float32(ctx.GetTextWidth(shown)/2) + bounds.Width/2 + 1
+	} else if textAlignment == TextAlignRight {
+		cursor.X = bounds.X + bounds.Width - float32(ctx.GetStyle(TextBoxControl, TextInnerPadding))
+	}
+
+	if edit != nil {
+		cursor.X -= float32(ctx.GetTextWidth(shown) - ctx.GetTextWidth(shown[:shownEdit.cursor]))
+	}
 	//--------------------------------------------------------------------
 
 	// Draw control
 	//--------------------------------------------------------------------
 	if state == StatePressed {
-		DrawRectangle(bounds, int(GetStyle(TextBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, BaseColorPressedProp))), guiAlpha))
+		ctx.DrawRectangle(bounds, int(ctx.GetStyle(TextBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BaseColorPressedProp))), ctx.alpha))
 	} else if state == StateDisabled {
-		DrawRectangle(bounds, int(GetStyle(TextBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, BaseColorDisabledProp))), guiAlpha))
+		ctx.DrawRectangle(bounds, int(ctx.GetStyle(TextBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BaseColorDisabledProp))), ctx.alpha))
 	} else {
-		DrawRectangle(bounds, 1, rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), guiAlpha), rl.Blank)
+		ctx.DrawRectangle(bounds, 1, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Blank)
 	}
 
-	DrawText(text, GetTextBounds(TextBoxControl, bounds), TextAlignment(GetStyle(TextBoxControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, Text+(ControlProperty(state)*3)))), guiAlpha))
+	textColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha)
+	if edit != nil && edit.anchor != edit.cursor {
+		ctx.textBoxDrawSelection(bounds, shown, &shownEdit, textColor)
+	} else if text == "" && options.Placeholder != "" {
+		ctx.DrawText(options.Placeholder, ctx.GetTextBounds(TextBoxControl, bounds), textAlignment, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, TextColorDisabledProp))), ctx.alpha))
+	} else {
+		ctx.DrawText(shown, ctx.GetTextBounds(TextBoxControl, bounds), textAlignment, textColor)
+	}
 
 	// Draw cursor
 	if editMode {
-		DrawRectangle(cursor, 0, rl.Blank, rl.Fade(rl.GetColor(int32(GetStyle(TextBoxControl, BorderColorPressedProp))), guiAlpha))
+		ctx.DrawRectangle(cursor, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BorderColorPressedProp))), ctx.alpha))
 	}
 	//--------------------------------------------------------------------
 
 	return text, pressed
 }
 
-// Status Bar control
-func StatusBar(bounds rl.Rectangle, text string) {
-	state := guiState
+// Time in seconds a spinner arrow has to be held before it starts repeating
+const SpinnerRepeatDelay = 0.5
 
-	// Draw control
-	//--------------------------------------------------------------------
-	var borderColorProp ControlProperty
-	var baseColorProp ControlProperty
-	var textColorProp ControlProperty
-	if state != StateDisabled {
-		borderColorProp = BorderColorNormalProp
-		baseColorProp = BaseColorNormalProp
-		textColorProp = TextColorNormalProp
-	} else {
-		borderColorProp = BorderColorDisabledProp
-		baseColorProp = BaseColorDisabledProp
-		textColorProp = TextColorDisabledProp
+// Time in seconds between repeated steps while a spinner arrow is held
+const SpinnerRepeatInterval = 0.05
+
+// Get the number of steps a spinner arrow applies this frame, taking into
+// account the click on release and the repeat while it is held down
+func (ctx *Context) spinnerArrowSteps(bounds rl.Rectangle, clicked bool) int {
+	if ctx.state == StateDisabled || ctx.locked {
+		return 0
 	}
-	DrawRectangle(bounds, int(GetStyle(StatusBarControl, BorderWidthProp)),
-		rl.Fade(rl.GetColor(int32(GetStyle(StatusBarControl, borderColorProp))), guiAlpha),
-		rl.Fade(rl.GetColor(int32(GetStyle(StatusBarControl, baseColorProp))), guiAlpha),
-	)
-	DrawText(text, GetTextBounds(StatusBarControl, bounds), TextAlignment(GetStyle(StatusBarControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(GetStyle(StatusBarControl, textColorProp))), guiAlpha))
-	//--------------------------------------------------------------------
-}
 
-// Scroll Bar control
-// TODO: I feel GuiScrollBar could be simplified...
-func ScrollBar(bounds rl.Rectangle, value, minValue, maxValue int) int {
-	state := guiState
+	held := ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) && rl.CheckCollisionPointRec(ctx.backend.GetMousePosition(), bounds)
 
-	// Is the scrollbar horizontal or vertical?
-	isVertical := bounds.Width <= bounds.Height
+	if !held {
+		if ctx.spinnerHeldBounds == bounds {
+			repeated := ctx.spinnerRepeated
+			ctx.spinnerHeldBounds = rl.Rectangle{}
+			ctx.spinnerHeldTime = 0
+			ctx.spinnerRepeated = false
 
-	// The size (width or height depending on scrollbar type) of the spinner buttons
-	spinnerSize := 0
-	if GetStyle(ScrollBarControl, ArrowsVisible) > 0 {
-		if isVertical {
-			spinnerSize = int(bounds.Width - float32(2*GetStyle(ScrollBarControl, BorderWidthProp)))
-		} else {
-			spinnerSize = int(bounds.Height - float32(2*GetStyle(ScrollBarControl, BorderWidthProp)))
+			// The steps were already applied while holding, ignore the release
+			if repeated {
+				return 0
+			}
 		}
-	}
 
-	// Arrow buttons [<] [>] [∧] [∨]
-	var arrowUpLeft rl.Rectangle
-	var arrowDownRight rl.Rectangle
+		if clicked {
+			return 1
+		}
+		return 0
+	}
 
-	// Actual area of the scrollbar excluding the arrow buttons
-	var scrollbar rl.Rectangle
+	if ctx.spinnerHeldBounds != bounds {
+		ctx.spinnerHeldBounds = bounds
+		ctx.spinnerHeldTime = 0
+		ctx.spinnerRepeated = false
+		return 0
+	}
 
-	// Slider bar that moves     --[///]-----
-	var slider rl.Rectangle
+	prevTime := ctx.spinnerHeldTime
+	ctx.spinnerHeldTime += ctx.backend.GetFrameTime()
+	if ctx.spinnerHeldTime < SpinnerRepeatDelay {
+		return 0
+	}
 
-	// Normalize value
-	if value > maxValue {
-		value = maxValue
+	// First step happens as soon as the delay is reached
+	prevSteps := -1
+	if prevTime >= SpinnerRepeatDelay {
+		prevSteps = int((prevTime - SpinnerRepeatDelay) / SpinnerRepeatInterval)
 	}
-	if value < minValue {
-		value = minValue
+	steps := int((ctx.spinnerHeldTime-SpinnerRepeatDelay)/SpinnerRepeatInterval) - prevSteps
+
+	if steps > 0 {
+		ctx.spinnerRepeated = true
 	}
 
-	_range := maxValue - minValue
-	sliderSize := int(GetStyle(ScrollBarControl, ScrollSliderSize))
+	return steps
+}
 
-	// Calculate rectangles for all of the components
-	arrowUpLeft = rl.Rectangle{
-		bounds.X + float32(GetStyle(ScrollBarControl, BorderWidthProp)),
-		bounds.Y + float32(GetStyle(ScrollBarControl, BorderWidthProp)),
-		float32(spinnerSize),
-		float32(spinnerSize),
+// Spinner control, returns selected value
+//
+// NOTE(port): An empty string is used in place of NULL for text.
+// Holding down one of the arrow buttons repeats its step.
+func (ctx *Context) Spinner(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	state := ctx.state
+
+	pressed := false
+	focusPressed := false
+	tempValue := *value
+
+	spinner := rl.Rectangle{
+		X:      bounds.X + float32(ctx.GetStyle(SpinnerControl, SpinButtonWidth)) + float32(ctx.GetStyle(SpinnerControl, SpinButtonPadding)),
+		Y:      bounds.Y,
+		Width:  bounds.Width - 2*(float32(ctx.GetStyle(SpinnerControl, SpinButtonWidth))+float32(ctx.GetStyle(SpinnerControl, SpinButtonPadding))),
+		Height: bounds.Height,
 	}
+	leftButtonBound := rl.Rectangle{bounds.X, bounds.Y, float32(ctx.GetStyle(SpinnerControl, SpinButtonWidth)), bounds.Height}
+	rightButtonBound := rl.Rectangle{bounds.X + bounds.Width - float32(ctx.GetStyle(SpinnerControl, SpinButtonWidth)), bounds.Y, float32(ctx.GetStyle(SpinnerControl, SpinButtonWidth)), bounds.Height}
 
-	if isVertical {
-		arrowDownRight = rl.Rectangle{bounds.X + float32(GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Y + bounds.Height - float32(spinnerSize) - float32(GetStyle(ScrollBarControl, BorderWidthProp)), float32(spinnerSize), float32(spinnerSize)}
-		scrollbar = rl.Rectangle{bounds.X + float32(GetStyle(ScrollBarControl, BorderWidthProp)) + float32(GetStyle(ScrollBarControl, ScrollPadding)), arrowUpLeft.Y + arrowUpLeft.Height, bounds.Width - 2*(float32(GetStyle(ScrollBarControl, BorderWidthProp))+float32(GetStyle(ScrollBarControl, ScrollPadding))), bounds.Height - arrowUpLeft.Height - arrowDownRight.Height - float32(2*GetStyle(ScrollBarControl, BorderWidthProp))}
-		if float32(sliderSize) >= scrollbar.Height {
-			sliderSize = int(scrollbar.Height) - 2 // Make sure the slider won't get outside of the scrollbar
-		}
-		slider = rl.Rectangle{bounds.X + float32(GetStyle(ScrollBarControl, BorderWidthProp)) + float32(GetStyle(ScrollBarControl, ScrollSliderPadding)), scrollbar.Y + floor32((float32(value-minValue)/float32(_range))*(scrollbar.Height-float32(sliderSize))), bounds.Width - 2*(float32(GetStyle(ScrollBarControl, BorderWidthProp))+float32(GetStyle(ScrollBarControl, ScrollSliderPadding))), float32(sliderSize)}
-	} else {
-		arrowDownRight = rl.Rectangle{bounds.X + bounds.Width - float32(spinnerSize) - float32(GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Y + float32(GetStyle(ScrollBarControl, BorderWidthProp)), float32(spinnerSize), float32(spinnerSize)}
-		scrollbar = rl.Rectangle{arrowUpLeft.X + arrowUpLeft.Width, bounds.Y + float32(GetStyle(ScrollBarControl, BorderWidthProp)) + float32(GetStyle(ScrollBarControl, ScrollPadding)), bounds.Width - arrowUpLeft.Width - arrowDownRight.Width - float32(2*GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Height - 2*(float32(GetStyle(ScrollBarControl, BorderWidthProp))+float32(GetStyle(ScrollBarControl, ScrollPadding)))}
-		if float32(sliderSize) >= scrollbar.Width {
-			sliderSize = int(scrollbar.Width) - 2 // Make sure the slider won't get outside of the scrollbar
+	var textBounds rl.Rectangle
+	if text != "" {
+		textBounds.Width = float32(ctx.GetTextWidth(text))
+		textBounds.Height = float32(ctx.GetStyle(Default, TextSizeProp))
+		textBounds.X = bounds.X + bounds.Width + float32(ctx.GetStyle(SpinnerControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+		if TextAlignment(ctx.GetStyle(SpinnerControl, TextAlignmentProp)) == TextAlignLeft {
+			textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(SpinnerControl, TextPaddingProp))
 		}
-		slider = rl.Rectangle{scrollbar.X + floor32((float32(value-minValue)/float32(_range))*(scrollbar.Width-float32(sliderSize))), bounds.Y + float32(GetStyle(ScrollBarControl, BorderWidthProp)) + float32(GetStyle(ScrollBarControl, ScrollSliderPadding)), float32(sliderSize), bounds.Height - 2*(float32(GetStyle(ScrollBarControl, BorderWidthProp))+float32(GetStyle(ScrollBarControl, ScrollSliderPadding)))}
 	}
 
 	// Update control
 	//--------------------------------------------------------------------
-	if (state != StateDisabled) && !guiLocked {
-		mousePoint := rl.GetMousePosition()
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
+		// Check spinner state
 		if rl.CheckCollisionPointRec(mousePoint, bounds) {
-			state = StateFocused
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				state = StatePressed
+			} else {
+				state = StateFocused
+			}
+		}
 
-			// Handle mouse wheel
-			wheel := int(rl.GetMouseWheelMove())
-			if wheel != 0 {
-				value += wheel
+		// Undo and redo edits, typed into the value box or made with the arrow buttons
+		if editMode {
+			if undone, ok := ctx.undoKeys(SpinnerControl, bounds, UndoState{Value: float64(tempValue)}); ok {
+				tempValue = int(undone.Value)
 			}
+		}
 
-			if rl.IsMouseButtonPressed(rl.MouseLeftButton) {
-				if rl.CheckCollisionPointRec(mousePoint, arrowUpLeft) {
-					value -= _range / int(GetStyle(ScrollBarControl, ScrollSpeed))
-				} else if rl.CheckCollisionPointRec(mousePoint, arrowDownRight) {
-					value += _range / int(GetStyle(ScrollBarControl, ScrollSpeed))
-				}
+		// Check keyboard focus, arrow keys step the value and activating starts edit mode,
+		// Tab ends edit mode moving focus
+		if ctx.focusControl(SpinnerControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
 
-				state = StatePressed
-			} else if rl.IsMouseButtonDown(rl.MouseLeftButton) {
-				if !isVertical {
-					scrollArea := rl.Rectangle{arrowUpLeft.X + arrowUpLeft.Width, arrowUpLeft.Y, scrollbar.Width, bounds.Height - float32(2*GetStyle(ScrollBarControl, BorderWidthProp))}
-					if rl.CheckCollisionPointRec(mousePoint, scrollArea) {
-						value = int(((mousePoint.X-scrollArea.X-slider.Width/2)*float32(_range))/(scrollArea.Width-slider.Width) + float32(minValue))
-					}
-				} else {
-					scrollArea := rl.Rectangle{arrowUpLeft.X, arrowUpLeft.Y + arrowUpLeft.Height, bounds.Width - float32(2*GetStyle(ScrollBarControl, BorderWidthProp)), scrollbar.Height}
-					if rl.CheckCollisionPointRec(mousePoint, scrollArea) {
-						value = int(((mousePoint.Y-scrollArea.Y-slider.Height/2)*float32(_range))/(scrollArea.Height-slider.Height) + float32(minValue))
-					}
-				}
+			if editMode {
+				focusPressed = ctx.focusTabbed()
+			} else {
+				focusPressed = ctx.focusActivated()
+				tempValue += ctx.focusStep()
 			}
 		}
+	}
 
-		// Normalize value
-		if value > maxValue {
-			value = maxValue
+	if !editMode {
+		if tempValue < minValue {
+			tempValue = minValue
 		}
-		if value < minValue {
-			value = minValue
+		if tempValue > maxValue {
+			tempValue = maxValue
 		}
 	}
 	//--------------------------------------------------------------------
+	undoValue := tempValue
 
 	// Draw control
 	//--------------------------------------------------------------------
-	DrawRectangle(bounds, int(GetStyle(ScrollBarControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(GetStyle(ListViewControl, Border+ControlProperty(state)*3))), guiAlpha), rl.Fade(rl.GetColor(int32(GetStyle(Default, BorderColorDisabledProp))), guiAlpha)) // Draw the background
-
-	DrawRectangle(scrollbar, 0, rl.Blank, rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, BaseColorNormalProp))), guiAlpha))          // Draw the scrollbar active area background
-	DrawRectangle(slider, 0, rl.Blank, rl.Fade(rl.GetColor(int32(GetStyle(SliderControl, Border+ControlProperty(state)*3))), guiAlpha)) // Draw the slider bar
+	// TODO: Set Spinner properties for ValueBox
+	// NOTE(port): Value box edits are recorded for undo as spinner edits
+	ctx.undoDisabled = true
+	pressed = ctx.ValueBox(spinner, "", &tempValue, minValue, maxValue, editMode) || focusPressed
+	ctx.undoDisabled = false
+	typedValue := tempValue
+
+	// Draw value selector custom buttons
+	// NOTE: BORDER_WIDTH and TEXT_ALIGNMENT forced values
+	tempBorderWidth := ctx.GetStyle(ButtonControl, BorderWidthProp)
+	tempTextAlign := ctx.GetStyle(ButtonControl, TextAlignmentProp)
+	ctx.SetStyle(ButtonControl, BorderWidthProp, ctx.GetStyle(SpinnerControl, BorderWidthProp))
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
+
+	// NOTE(port): Arrow buttons are part of the spinner, they can't get keyboard focus
+	ctx.focusDisabled = true
+	tempValue -= ctx.spinnerArrowSteps(leftButtonBound, ctx.Button(leftButtonBound, IconText(IconArrowLeftFill, "")))
+	tempValue += ctx.spinnerArrowSteps(rightButtonBound, ctx.Button(rightButtonBound, IconText(IconArrowRightFill, "")))
+	ctx.focusDisabled = false
+
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, tempTextAlign)
+	ctx.SetStyle(ButtonControl, BorderWidthProp, tempBorderWidth)
+
+	// Record edit for undo, typing merges into last step
+	if tempValue != undoValue {
+		ctx.undoRecord(SpinnerControl, bounds, UndoState{Value: float64(undoValue)}, UndoState{Value: float64(tempValue)}, tempValue == typedValue)
+	}
 
-	// Draw arrows
-	padding := (spinnerSize - int(GetStyle(ScrollBarControl, ArrowsSize))) / 2
-	lineCoords := []rl.Vector2{
-		// Coordinates for <     0,1,2
-		{arrowUpLeft.X + float32(padding), arrowUpLeft.Y + float32(spinnerSize/2)},
-		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(padding)},
-		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
+	// Draw text label if provided
+	var align TextAlignment
+	if TextAlignment(ctx.GetStyle(SpinnerControl, TextAlignmentProp)) == TextAlignRight {
+		align = TextAlignLeft
+	} else {
+		align = TextAlignRight
+	}
+	ctx.DrawText(text, textBounds, align, rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	//--------------------------------------------------------------------
 
-		// Coordinates for >     3,4,5
-		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(padding)},
-		{arrowDownRight.X + float32(spinnerSize) - float32(padding), arrowDownRight.Y + float32(spinnerSize/2)},
-		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(spinnerSize) - float32(padding)},
+	*value = tempValue
+	return pressed
+}
 
-		// Coordinates for ∧     6,7,8
-		{arrowUpLeft.X + float32(spinnerSize/2), arrowUpLeft.Y + float32(padding)},
-		{arrowUpLeft.X + float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
-		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
+const ValueBoxMaxChars = 32
 
-		// Coordinates for ∨     9,10,11
-		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(padding)},
-		{arrowDownRight.X + float32(spinnerSize/2), arrowDownRight.Y + float32(spinnerSize) - float32(padding)},
-		{arrowDownRight.X + float32(spinnerSize) - float32(padding), arrowDownRight.Y + float32(padding)},
-	}
+// Value Box control, updates input text with numbers
+//
+// NOTE(port): An empty string is used in place of NULL for text. Pressing
+// '-' while editing negates the value, since the text is rebuilt from the
+// integer every frame and cannot hold a lone minus sign.
+func (ctx *Context) ValueBox(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool {
+	state := ctx.state
+	pressed := false
 
-	lineColor := rl.Fade(rl.GetColor(int32(GetStyle(ButtonControl, Text+ControlProperty(state)*3))), guiAlpha)
+	textValue := strconv.Itoa(*value)
 
-	if GetStyle(ScrollBarControl, ArrowsVisible) > 0 {
-		if isVertical {
-			rl.DrawTriangle(lineCoords[6], lineCoords[7], lineCoords[8], lineColor)
-			rl.DrawTriangle(lineCoords[9], lineCoords[10], lineCoords[11], lineColor)
-		} else {
-			rl.DrawTriangle(lineCoords[2], lineCoords[1], lineCoords[0], lineColor)
-			rl.DrawTriangle(lineCoords[5], lineCoords[4], lineCoords[3], lineColor)
+	var textBounds rl.Rectangle
+	if text != "" {
+		textBounds.Width = float32(ctx.GetTextWidth(text))
+		textBounds.Height = float32(ctx.GetStyle(Default, TextSizeProp))
+		textBounds.X = bounds.X + bounds.Width + float32(ctx.GetStyle(ValueBoxControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+		if TextAlignment(ctx.GetStyle(ValueBoxControl, TextAlignmentProp)) == TextAlignLeft {
+			textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(ValueBoxControl, TextPaddingProp))
 		}
 	}
-	//--------------------------------------------------------------------
 
-	return value
-}
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
 
-// Load style default over global style
-func LoadStyleDefault() {
-	// We set this variable first to avoid cyclic function calls
-	// when calling GuiSetStyle() and GuiGetStyle()
-	guiStyleLoaded = true
+		valueHasChanged := false
 
-	// Initialize default LIGHT style property values
-	SetStyle(Default, BorderColorNormalProp, 0x838383ff)
-	SetStyle(Default, BaseColorNormalProp, 0xc9c9c9ff)
-	SetStyle(Default, TextColorNormalProp, 0x686868ff)
-	SetStyle(Default, BorderColorFocusedProp, 0x5bb2d9ff)
-	SetStyle(Default, BaseColorFocusedProp, 0xc9effeff)
-	SetStyle(Default, TextColorFocusedProp, 0x6c9bbcff)
-	SetStyle(Default, BorderColorPressedProp, 0x0492c7ff)
-	SetStyle(Default, BaseColorPressedProp, 0x97e8ffff)
-	SetStyle(Default, TextColorPressedProp, 0x368bafff)
-	SetStyle(Default, BorderColorDisabledProp, 0xb5c1c2ff)
-	SetStyle(Default, BaseColorDisabledProp, 0xe6e9e9ff)
-	SetStyle(Default, TextColorDisabledProp, 0xaeb7b8ff)
-	SetStyle(Default, BorderWidthProp, 1)                       // WARNING: Some controls use other values
-	SetStyle(Default, TextPaddingProp, 0)                       // WARNING: Some controls use other values
-	SetStyle(Default, TextAlignmentProp, uint(TextAlignCenter)) // WARNING: Some controls use other values
+		if editMode {
+			state = StatePressed
 
-	// Initialize control-specific property values
-	// NOTE: Those properties are in default list but require specific values by control type
-	SetStyle(LabelControl, TextAlignmentProp, uint(TextAlignLeft))
-	SetStyle(ButtonControl, BorderWidthProp, 2)
-	SetStyle(SliderControl, TextPaddingProp, 5)
-	SetStyle(CheckBoxControl, TextPaddingProp, 5)
-	SetStyle(CheckBoxControl, TextAlignmentProp, uint(TextAlignRight))
-	SetStyle(TextBoxControl, TextPaddingProp, 5)
-	SetStyle(TextBoxControl, TextAlignmentProp, uint(TextAlignLeft))
-	SetStyle(ValueBoxControl, TextPaddingProp, 4)
-	SetStyle(ValueBoxControl, TextAlignmentProp, uint(TextAlignLeft))
-	SetStyle(SpinnerControl, TextPaddingProp, 4)
-	SetStyle(SpinnerControl, TextAlignmentProp, uint(TextAlignLeft))
-	SetStyle(StatusBarControl, TextPaddingProp, 6)
-	SetStyle(StatusBarControl, TextAlignmentProp, uint(TextAlignLeft))
+			if undone, ok := ctx.undoKeys(ValueBoxControl, bounds, UndoState{Value: float64(*value)}); ok {
+				*value = int(undone.Value)
+				textValue = strconv.Itoa(*value)
+			}
 
-	// Initialize extended property values
-	// NOTE: By default, extended property values are initialized to 0
-	SetStyle(Default, TextSizeProp, 10)                // Default, shared by all controls
-	SetStyle(Default, TextSpacingProp, 1)              // Default, shared by all controls
-	SetStyle(Default, LineColorProp, 0x90abb5ff)       // Default specific property
-	SetStyle(Default, BackgroundColorProp, 0xf5f5f5ff) // Default specific property
-	SetStyle(ToggleControl, GroupPadding, 2)
-	SetStyle(SliderControl, SliderWidth, 15)
-	SetStyle(SliderControl, SliderPadding, 1)
-	SetStyle(ProgressBarControl, ProgressPadding, 1)
-	SetStyle(CheckBoxControl, CheckPadding, 1)
-	SetStyle(ComboBoxControl, ComboButtonWidth, 30)
-	SetStyle(ComboBoxControl, ComboButtonPadding, 2)
-	SetStyle(DropdownBoxControl, ArrowPadding, 16)
-	SetStyle(DropdownBoxControl, DropdownItemsPadding, 2)
-	SetStyle(TextBoxControl, TextLinesPadding, 5)
-	SetStyle(TextBoxControl, TextInnerPadding, 4)
-	SetStyle(TextBoxControl, ColorSelectedFG, 0xf0fffeff)
-	SetStyle(TextBoxControl, ColorSelectedBG, 0x839affe0)
-	SetStyle(SpinnerControl, SpinButtonWidth, 20)
-	SetStyle(SpinnerControl, SpinButtonPadding, 2)
-	SetStyle(ScrollBarControl, BorderWidthProp, 0)
-	SetStyle(ScrollBarControl, ArrowsVisible, 0)
-	SetStyle(ScrollBarControl, ArrowsSize, 6)
-	SetStyle(ScrollBarControl, ScrollSliderPadding, 0)
-	SetStyle(ScrollBarControl, ScrollSliderSize, 16)
-	SetStyle(ScrollBarControl, ScrollPadding, 0)
-	SetStyle(ScrollBarControl, ScrollSpeed, 10)
-	SetStyle(ListViewControl, ListItemsHeight, 0x1e)
-	SetStyle(ListViewControl, ListItemsPadding, 2)
-	SetStyle(ListViewControl, ScrollBarWidth, 10)
-	SetStyle(ListViewControl, ScrollBarSideProp, uint(ScrollBarRightSide))
-	SetStyle(ColorPickerControl, ColorSelectorSize, 6)
-	SetStyle(ColorPickerControl, HueBarWidth, 0x14)
-	SetStyle(ColorPickerControl, HueBarPadding, 0xa)
-	SetStyle(ColorPickerControl, HueBarSelectorHeight, 6)
-	SetStyle(ColorPickerControl, HueBarSelectorOverflow, 2)
-
-	guiFont = rl.GetFontDefault() // Initialize default font
-}
+			keyCount := len(textValue)
 
-func bitCheck(a, b uint32) uint32 {
-	return a & 1 << b
-}
+			// Only allow keys in range [48..57] and the minus sign
+			key := ctx.backend.GetCharPressed()
+			if key == '-' {
+				if textValue[0] == '-' {
+					textValue = textValue[1:]
+				} else {
+					textValue = "-" + textValue
+				}
+				keyCount = len(textValue)
+				valueHasChanged = true
+			} else if keyCount < ValueBoxMaxChars {
+				if float32(ctx.GetTextWidth(textValue)) < bounds.Width {
+					if key >= '0' && key <= '9' {
+						textValue += string(rune(key))
+						keyCount++
+						valueHasChanged = true
+					}
+				}
+			}
+
+			// Delete text
+			if keyCount > 0 {
+				if ctx.backend.IsKeyPressed(rl.KeyBackspace) {
+					keyCount--
+					textValue = textValue[:keyCount]
+					valueHasChanged = true
+				}
+			}
+
+			// Typed digits overflowing the value are dropped
+			if _, err := strconv.Atoi(textValue); errors.Is(err, strconv.ErrRange) {
+				textValue = strconv.Itoa(*value)
+				valueHasChanged = false
+			}
+
+			if valueHasChanged {
+				// Record edit for undo, typing digits merges into last step
+				before := *value
+				*value = TextToInteger(textValue)
+				if *value != before {
+					ctx.undoRecord(ValueBoxControl, bounds, UndoState{Value: float64(before)}, UndoState{Value: float64(*value)}, key >= '0' && key <= '9')
+				}
+			}
+
+			if ctx.backend.IsKeyPressed(rl.KeyEnter) || (!rl.CheckCollisionPointRec(mousePoint, bounds) && ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton)) {
+				pressed = true
+			}
+		} else {
+			if *value > maxValue {
+				*value = maxValue
+			} else if *value < minValue {
+				*value = minValue
+			}
+
+			if rl.CheckCollisionPointRec(mousePoint, bounds) {
+				state = StateFocused
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+					pressed = true
+				}
+			}
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	baseColor := rl.Blank
+	if state == StatePressed {
+		baseColor = rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, BaseColorPressedProp)))
+	} else if state == StateDisabled {
+		baseColor = rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, BaseColorDisabledProp)))
+	}
+
+	// WARNING: BLANK color does not work properly with Fade()
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ValueBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), baseColor)
+	ctx.DrawText(textValue, ctx.GetTextBounds(ValueBoxControl, bounds), TextAlignCenter, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+
+	// Draw cursor
+	if editMode {
+		// NOTE: ValueBox internal text is always centered
+		cursor := rl.Rectangle{
+			X:      bounds.X + float32(ctx.GetTextWidth(textValue)/2) + bounds.Width/2 + 2,
+			Y:      bounds.Y + 2*float32(ctx.GetStyle(ValueBoxControl, BorderWidthProp)),
+			Width:  4,
+			Height: bounds.Height - 4*float32(ctx.GetStyle(ValueBoxControl, BorderWidthProp)),
+		}
+		ctx.DrawRectangle(cursor, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ValueBoxControl, BorderColorPressedProp))), ctx.alpha))
+	}
+
+	// Draw text label if provided
+	var align TextAlignment
+	if TextAlignment(ctx.GetStyle(ValueBoxControl, TextAlignmentProp)) == TextAlignRight {
+		align = TextAlignLeft
+	} else {
+		align = TextAlignRight
+	}
+	ctx.DrawText(text, textBounds, align, rl.Fade(rl.GetColor(int32(ctx.GetStyle(LabelControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	//--------------------------------------------------------------------
+
+	return pressed
+}
+
+// Line of a multi-line text, as byte offsets into the text
+// NOTE: end excludes the line feed for lines terminated by one
+type textLine struct {
+	start, end int
+}
+
+// Text Box control with multiple lines
+// Text is word wrapped to the control width and scrolled vertically with a
+// scroll panel when it does not fit. While editing, Enter inserts a new line,
+// the arrow keys, Home and End move the cursor through the wrapped lines and
+// Ctrl+Z and Ctrl+Y undo and redo edits.
+//
+// NOTE(port): The signature of this method is different because of differences
+// in how strings work between C and Go. Scroll and cursor position (byte offset
+// into text) are kept by the caller, pass nil to keep the cursor at the end of
+// the text and the view scrolled to the top.
+func (ctx *Context) TextBoxMulti(bounds rl.Rectangle, text string, textSize int, scroll *rl.Vector2, cursor *int, editMode bool) (string, bool) {
+	state := ctx.state
+	pressed := false
+
+	scrollPos := rl.Vector2{0, 0}
+	if scroll != nil {
+		scrollPos = *scroll
+	}
+
+	cursorPos := len(text)
+	if cursor != nil && *cursor >= 0 && *cursor <= len(text) {
+		cursorPos = textClampOffset(text, *cursor)
+	}
+
+	borderWidth := float32(ctx.GetStyle(TextBoxControl, BorderWidthProp))
+	innerPadding := float32(ctx.GetStyle(TextBoxControl, TextInnerPadding))
+	lineHeight := float32(ctx.GetStyle(Default, TextSizeProp)) + float32(ctx.GetStyle(TextBoxControl, TextLinesPadding))
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if editMode {
+			state = StatePressed
+
+			before := UndoState{Text: text, Cursor: cursorPos}
+
+			if undone, ok := ctx.undoKeys(TextBoxControl, bounds, before); ok {
+				text = undone.Text
+				cursorPos = textClampOffset(text, undone.Cursor)
+			} else {
+				// We get an Unicode codepoint
+				codepoint := ctx.backend.GetCharPressed()
+				typing := false
+
+				// Introduce characters
+				// NOTE(port): textSize limits the number of characters, not bytes
+				if utf8.RuneCountInString(text) < textSize-1 {
+					if ctx.backend.IsKeyPressed(rl.KeyEnter) {
+						text = text[:cursorPos] + "\n" + text[cursorPos:]
+						cursorPos++
+					} else if codepoint >= 32 {
+						// Supports Unicode inputs -> Encoded to UTF-8
+						byteSize := 0
+						textUTF8 := CodepointToUTF8(codepoint, &byteSize)
+						text = text[:cursorPos] + textUTF8 + text[cursorPos:]
+						cursorPos += byteSize
+						typing = true
+					}
+				}
+
+				// Delete characters
+				if cursorPos > 0 && ctx.backend.IsKeyPressed(rl.KeyBackspace) {
+					start := textCharLeft(text, cursorPos)
+					text = text[:start] + text[cursorPos:]
+					cursorPos = start
+					typing = false
+				}
+
+				// Record edit for undo, typing merges into last step
+				if text != before.Text {
+					ctx.undoRecord(TextBoxControl, bounds, before, UndoState{Text: text, Cursor: cursorPos}, typing)
+				}
+			}
+
+			// Exit edit mode
+			if !rl.CheckCollisionPointRec(mousePoint, bounds) && ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+				pressed = true
+			}
+		} else {
+			if rl.CheckCollisionPointRec(mousePoint, bounds) {
+				state = StateFocused
+				if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+					pressed = true
+				}
+			}
+		}
+	}
+
+	// Wrap text to the available width, leaving room for the scroll bar when it does not fit
+	wrapWidth := bounds.Width - 2*borderWidth - 2*innerPadding
+	lines := ctx.wrapTextLines(text, wrapWidth)
+	if float32(len(lines))*lineHeight+2*innerPadding > bounds.Height-2*borderWidth {
+		wrapWidth -= float32(ctx.GetStyle(ListViewControl, ScrollBarWidth))
+		lines = ctx.wrapTextLines(text, wrapWidth)
+	}
+
+	cursorLine := textLineAt(lines, cursorPos)
+
+	// Move cursor through the wrapped lines
+	if editMode && state == StatePressed {
+		line := lines[cursorLine]
+		movedFrom := cursorPos
+
+		if ctx.backend.IsKeyPressed(rl.KeyLeft) && cursorPos > 0 {
+			cursorPos = textCharLeft(text, cursorPos)
+		} else if ctx.backend.IsKeyPressed(rl.KeyRight) && cursorPos < len(text) {
+			cursorPos = textCharRight(text, cursorPos)
+		} else if ctx.backend.IsKeyPressed(rl.KeyHome) {
+			cursorPos = line.start
+		} else if ctx.backend.IsKeyPressed(rl.KeyEnd) {
+			cursorPos = line.end
+
+			// Stay in front of the space the line was wrapped on, otherwise the
+			// cursor would be shown at the start of the next line
+			if cursorLine < len(lines)-1 && lines[cursorLine+1].start == line.end && cursorPos > line.start && text[cursorPos-1] == ' ' {
+				cursorPos--
+			}
+		} else if ctx.backend.IsKeyPressed(rl.KeyUp) && cursorLine > 0 {
+			cursorPos = ctx.textLineOffsetAt(text, lines[cursorLine-1], ctx.measureText(text[line.start:cursorPos]))
+		} else if ctx.backend.IsKeyPressed(rl.KeyDown) && cursorLine < len(lines)-1 {
+			cursorPos = ctx.textLineOffsetAt(text, lines[cursorLine+1], ctx.measureText(text[line.start:cursorPos]))
+		}
+
+		// Typing after moving the cursor starts a new undo step
+		if cursorPos != movedFrom {
+			ctx.undoBreak(TextBoxControl, bounds)
+		}
+
+		cursorLine = textLineAt(lines, cursorPos)
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	content := rl.Rectangle{0, 0, wrapWidth + 2*innerPadding, float32(len(lines))*lineHeight + 2*innerPadding}
+
+	// Arrow keys move the cursor while editing, don't let the panel scroll with them too
+	locked := ctx.locked
+	if editMode && (ctx.backend.IsKeyDown(rl.KeyUp) || ctx.backend.IsKeyDown(rl.KeyDown)) {
+		ctx.locked = true
+	}
+	view := ctx.ScrollPanel(bounds, content, &scrollPos)
+	ctx.locked = locked
+
+	// Keep the cursor inside the view while editing
+	if editMode {
+		cursorTop := bounds.Y + scrollPos.Y + innerPadding + float32(cursorLine)*lineHeight
+		if cursorTop < view.Y {
+			scrollPos.Y += view.Y - cursorTop
+		} else if cursorTop+lineHeight > view.Y+view.Height {
+			scrollPos.Y -= cursorTop + lineHeight - (view.Y + view.Height)
+		}
+	}
+
+	if state == StatePressed {
+		ctx.DrawRectangle(view, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BaseColorPressedProp))), ctx.alpha))
+	} else if state == StateDisabled {
+		ctx.DrawRectangle(view, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BaseColorDisabledProp))), ctx.alpha))
+	}
+
+	ctx.backend.BeginScissorMode(int32(view.X), int32(view.Y), int32(view.Width), int32(view.Height))
+
+	textColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Text+(ControlProperty(state)*3)))), ctx.alpha)
+	origin := rl.Vector2{bounds.X + scrollPos.X + innerPadding, bounds.Y + scrollPos.Y + innerPadding}
+	for i, line := range lines {
+		position := rl.Vector2{floor32(origin.X), floor32(origin.Y + float32(i)*lineHeight)}
+		ctx.backend.DrawTextEx(ctx.font, text[line.start:line.end], position, float32(ctx.GetStyle(Default, TextSizeProp)), float32(ctx.GetStyle(Default, TextSpacingProp)), textColor)
+	}
+
+	// Draw cursor position considering text glyphs
+	if editMode {
+		line := lines[cursorLine]
+		cursorRec := rl.Rectangle{
+			X:      origin.X + ctx.measureText(text[line.start:cursorPos]),
+			Y:      origin.Y + float32(cursorLine)*lineHeight - 1,
+			Width:  4,
+			Height: float32(ctx.GetStyle(Default, TextSizeProp)) + 2,
+		}
+		ctx.DrawRectangle(cursorRec, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, BorderColorPressedProp))), ctx.alpha))
+	}
+
+	ctx.backend.EndScissorMode()
+
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(TextBoxControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Blank)
+	//--------------------------------------------------------------------
+
+	if scroll != nil {
+		*scroll = scrollPos
+	}
+	if cursor != nil {
+		*cursor = cursorPos
+	}
+
+	return text, pressed
+}
+
+// Wrap text into lines not wider than maxWidth
+// NOTE: Lines are broken after the last space that fits, words wider than
+// maxWidth are broken between characters, never before a combining mark
+func (ctx *Context) wrapTextLines(text string, maxWidth float32) []textLine {
+	spacing := float32(ctx.GetStyle(Default, TextSpacingProp))
+
+	var lines []textLine
+	start := 0
+	lastSpace := -1 // Byte offset right after the last space of the current line
+	var width float32
+
+	for i, r := range text {
+		if r == '\n' {
+			lines = append(lines, textLine{start, i})
+			start = i + 1
+			lastSpace = -1
+			width = 0
+			continue
+		}
+
+		glyphWidth := ctx.measureText(string(r))
+		if i > start {
+			glyphWidth += spacing
+		}
+
+		if width+glyphWidth > maxWidth && i > start && !textCombining(r) {
+			if lastSpace > start {
+				lines = append(lines, textLine{start, lastSpace})
+				start = lastSpace
+			} else {
+				lines = append(lines, textLine{start, i})
+				start = i
+			}
+			lastSpace = -1
+
+			width = ctx.measureText(text[start:i])
+			glyphWidth = ctx.measureText(string(r))
+			if i > start {
+				glyphWidth += spacing
+			}
+		}
+
+		width += glyphWidth
+		if r == ' ' {
+			lastSpace = i + 1
+		}
+	}
+
+	return append(lines, textLine{start, len(text)})
+}
+
+// Get index of the line containing the byte offset
+// NOTE: An offset shared by two wrapped lines belongs to the second one
+func textLineAt(lines []textLine, offset int) int {
+	index := 0
+	for i, line := range lines {
+		if line.start <= offset {
+			index = i
+		}
+	}
+	return index
+}
+
+// Get byte offset in line closest to the horizontal position x
+// NOTE: Only offsets between characters are considered, see textCharRight()
+func (ctx *Context) textLineOffsetAt(text string, line textLine, x float32) int {
+	offset := line.start
+	bestDistance := x
+
+	for end := line.start; end < line.end; {
+		end = textCharRight(text[:line.end], end)
+		distance := float32(math.Abs(float64(ctx.measureText(text[line.start:end]) - x)))
+		if distance < bestDistance {
+			offset = end
+			bestDistance = distance
+		}
+	}
+
+	return offset
+}
+
+// Slider control with pro parameters
+// NOTE: Other Slider() controls use this one
+//
+// NOTE(port): Empty strings are used in place of NULL for textLeft and textRight.
+func (ctx *Context) SliderPro(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32, sliderWidth int) float32 {
+	state := ctx.state
+
+	sliderValue := int(((value - minValue) / (maxValue - minValue)) * (bounds.Width - 2*float32(ctx.GetStyle(SliderControl, BorderWidthProp))))
+
+	slider := rl.Rectangle{
+		X:      bounds.X,
+		Y:      bounds.Y + float32(ctx.GetStyle(SliderControl, BorderWidthProp)) + float32(ctx.GetStyle(SliderControl, SliderPadding)),
+		Width:  0,
+		Height: bounds.Height - 2*float32(ctx.GetStyle(SliderControl, BorderWidthProp)) - 2*float32(ctx.GetStyle(SliderControl, SliderPadding)),
+	}
+
+	if sliderWidth > 0 { // Slider
+		slider.X += float32(sliderValue - sliderWidth/2)
+		slider.Width = float32(sliderWidth)
+	} else if sliderWidth == 0 { // SliderBar
+		slider.X += float32(ctx.GetStyle(SliderControl, BorderWidthProp))
+		slider.Width = float32(sliderValue)
+	}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if rl.CheckCollisionPointRec(mousePoint, bounds) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				state = StatePressed
+
+				// Get equivalent value and slider position from mousePoint.x
+				value = ((maxValue-minValue)*(mousePoint.X-(bounds.X+float32(sliderWidth/2))))/(bounds.Width-float32(sliderWidth)) + minValue
+
+				if sliderWidth > 0 { // Slider
+					slider.X = mousePoint.X - slider.Width/2
+				} else if sliderWidth == 0 { // SliderBar
+					slider.Width = float32(sliderValue)
+				}
+			} else {
+				state = StateFocused
+			}
+		}
+
+		if value > maxValue {
+			value = maxValue
+		} else if value < minValue {
+			value = minValue
+		}
+	}
+
+	// Bar limits check
+	if sliderWidth > 0 { // Slider
+		if slider.X <= bounds.X+float32(ctx.GetStyle(SliderControl, BorderWidthProp)) {
+			slider.X = bounds.X + float32(ctx.GetStyle(SliderControl, BorderWidthProp))
+		} else if slider.X+slider.Width >= bounds.X+bounds.Width {
+			slider.X = bounds.X + bounds.Width - slider.Width - float32(ctx.GetStyle(SliderControl, BorderWidthProp))
+		}
+	} else if sliderWidth == 0 { // SliderBar
+		if slider.Width > bounds.Width {
+			slider.Width = bounds.Width - 2*float32(ctx.GetStyle(SliderControl, BorderWidthProp))
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	baseColorProp := BaseColorNormalProp
+	if state == StateDisabled {
+		baseColorProp = BaseColorDisabledProp
+	}
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(SliderControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, baseColorProp))), ctx.alpha))
+
+	// Draw slider internal bar (depends on state)
+	if state == StateNormal || state == StatePressed {
+		ctx.DrawRectangle(slider, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, BaseColorPressedProp))), ctx.alpha))
+	} else if state == StateFocused {
+		ctx.DrawRectangle(slider, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, TextColorFocusedProp))), ctx.alpha))
+	}
+
+	// Draw left/right text if provided
+	if textLeft != "" {
+		textBounds := rl.Rectangle{
+			Width:  float32(ctx.GetTextWidth(textLeft)), // TODO: Consider text icon
+			Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+		}
+		textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(SliderControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+
+		ctx.DrawText(textLeft, textBounds, TextAlignRight, rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	}
+
+	if textRight != "" {
+		textBounds := rl.Rectangle{
+			Width:  float32(ctx.GetTextWidth(textRight)), // TODO: Consider text icon
+			Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+		}
+		textBounds.X = bounds.X + bounds.Width + float32(ctx.GetStyle(SliderControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+
+		ctx.DrawText(textRight, textBounds, TextAlignLeft, rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	}
+	//--------------------------------------------------------------------
+
+	return value
+}
+
+// Slider control extended, returns selected value and has text
+func (ctx *Context) Slider(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	return ctx.SliderPro(bounds, textLeft, textRight, value, minValue, maxValue, int(ctx.GetStyle(SliderControl, SliderWidth)))
+}
+
+// Slider Bar control extended, returns selected value
+func (ctx *Context) SliderBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	return ctx.SliderPro(bounds, textLeft, textRight, value, minValue, maxValue, 0)
+}
+
+// Progress Bar control extended, shows current progress value
+//
+// NOTE(port): The original C divides value by the range without subtracting
+// minValue first, so bars with a non-zero minimum were drawn too long.
+func (ctx *Context) ProgressBar(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32 {
+	state := ctx.state
+
+	progress := rl.Rectangle{
+		X:      bounds.X + float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)),
+		Y:      bounds.Y + float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ProgressBarControl, ProgressPadding)),
+		Width:  0,
+		Height: bounds.Height - 2*float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)) - 2*float32(ctx.GetStyle(ProgressBarControl, ProgressPadding)),
+	}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled {
+		progressValue := value
+		if progressValue > maxValue {
+			progressValue = maxValue
+		} else if progressValue < minValue {
+			progressValue = minValue
+		}
+		progress.Width = ((progressValue - minValue) / (maxValue - minValue)) * (bounds.Width - 2*float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)))
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	ctx.drawProgressBar(bounds, progress, textLeft, textRight, state)
+	//--------------------------------------------------------------------
+
+	return value
+}
+
+// Width of the moving segment of an indeterminate progress bar, relative to the bar width
+const ProgressBarMarqueeWidth = 0.25
+
+// Time in seconds the moving segment of an indeterminate progress bar takes to cross the bar
+const ProgressBarMarqueePeriod = 1.5
+
+// Progress Bar control for work of unknown length, draws a segment
+// moving across the bar driven by the frame time
+// NOTE: Segment moves once per frame in BeginFrame(), all bars drawn share its position
+func (ctx *Context) ProgressBarIndeterminate(bounds rl.Rectangle, textLeft, textRight string) {
+	state := ctx.state
+
+	inner := rl.Rectangle{
+		X:      bounds.X + float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)),
+		Y:      bounds.Y + float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ProgressBarControl, ProgressPadding)),
+		Width:  bounds.Width - 2*float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)),
+		Height: bounds.Height - 2*float32(ctx.GetStyle(ProgressBarControl, BorderWidthProp)) - 2*float32(ctx.GetStyle(ProgressBarControl, ProgressPadding)),
+	}
+
+	progress := inner
+	progress.Width = 0
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled {
+		segmentWidth := inner.Width * ProgressBarMarqueeWidth
+		phase := ctx.marqueeTime / ProgressBarMarqueePeriod
+
+		// The segment enters from the left edge and leaves through the right edge,
+		// so it travels the bar width plus its own width
+		start := inner.X - segmentWidth + phase*(inner.Width+segmentWidth)
+		end := start + segmentWidth
+
+		if start < inner.X {
+			start = inner.X
+		}
+		if end > inner.X+inner.Width {
+			end = inner.X + inner.Width
+		}
+
+		progress.X = start
+		if end > start {
+			progress.Width = end - start
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	ctx.drawProgressBar(bounds, progress, textLeft, textRight, state)
+	//--------------------------------------------------------------------
+}
+
+// Draw progress bar frame, filled area and left/right text
+func (ctx *Context) drawProgressBar(bounds, progress rl.Rectangle, textLeft, textRight string, state ControlState) {
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ProgressBarControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ProgressBarControl, Border+(ControlProperty(state)*3)))), ctx.alpha), rl.Blank)
+
+	// Draw slider internal progress bar (depends on state)
+	if state == StateNormal || state == StatePressed {
+		ctx.DrawRectangle(progress, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ProgressBarControl, BaseColorPressedProp))), ctx.alpha))
+	} else if state == StateFocused {
+		ctx.DrawRectangle(progress, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ProgressBarControl, TextColorFocusedProp))), ctx.alpha))
+	}
+
+	// Draw left/right text if provided
+	if textLeft != "" {
+		textBounds := rl.Rectangle{
+			Width:  float32(ctx.GetTextWidth(textLeft)), // TODO: Consider text icon
+			Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+		}
+		textBounds.X = bounds.X - textBounds.Width - float32(ctx.GetStyle(ProgressBarControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+
+		ctx.DrawText(textLeft, textBounds, TextAlignRight, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ProgressBarControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	}
+
+	if textRight != "" {
+		textBounds := rl.Rectangle{
+			Width:  float32(ctx.GetTextWidth(textRight)), // TODO: Consider text icon
+			Height: float32(ctx.GetStyle(Default, TextSizeProp)),
+		}
+		textBounds.X = bounds.X + bounds.Width + float32(ctx.GetStyle(ProgressBarControl, TextPaddingProp))
+		textBounds.Y = bounds.Y + bounds.Height/2 - float32(ctx.GetStyle(Default, TextSizeProp)/2)
+
+		ctx.DrawText(textRight, textBounds, TextAlignLeft, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ProgressBarControl, Text+(ControlProperty(state)*3)))), ctx.alpha))
+	}
+}
+
+// Status Bar control
+func (ctx *Context) StatusBar(bounds rl.Rectangle, text string) {
+	state := ctx.state
+
+	// Draw control
+	//--------------------------------------------------------------------
+	var borderColorProp ControlProperty
+	var baseColorProp ControlProperty
+	var textColorProp ControlProperty
+	if state != StateDisabled {
+		borderColorProp = BorderColorNormalProp
+		baseColorProp = BaseColorNormalProp
+		textColorProp = TextColorNormalProp
+	} else {
+		borderColorProp = BorderColorDisabledProp
+		baseColorProp = BaseColorDisabledProp
+		textColorProp = TextColorDisabledProp
+	}
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(StatusBarControl, BorderWidthProp)),
+		rl.Fade(rl.GetColor(int32(ctx.GetStyle(StatusBarControl, borderColorProp))), ctx.alpha),
+		rl.Fade(rl.GetColor(int32(ctx.GetStyle(StatusBarControl, baseColorProp))), ctx.alpha),
+	)
+	ctx.DrawText(text, ctx.GetTextBounds(StatusBarControl, bounds), TextAlignment(ctx.GetStyle(StatusBarControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(StatusBarControl, textColorProp))), ctx.alpha))
+	//--------------------------------------------------------------------
+}
+
+// Scroll Bar control
+// TODO: I feel GuiScrollBar could be simplified...
+func (ctx *Context) ScrollBar(bounds rl.Rectangle, value, minValue, maxValue int) int {
+	state := ctx.state
+
+	// Is the scrollbar horizontal or vertical?
+	isVertical := bounds.Width <= bounds.Height
+
+	// The size (width or height depending on scrollbar type) of the spinner buttons
+	spinnerSize := 0
+	if ctx.GetStyle(ScrollBarControl, ArrowsVisible) > 0 {
+		if isVertical {
+			spinnerSize = int(bounds.Width - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp)))
+		} else {
+			spinnerSize = int(bounds.Height - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp)))
+		}
+	}
+
+	// Arrow buttons [<] [>] [∧] [∨]
+	var arrowUpLeft rl.Rectangle
+	var arrowDownRight rl.Rectangle
+
+	// Actual area of the scrollbar excluding the arrow buttons
+	var scrollbar rl.Rectangle
+
+	// Slider bar that moves     --[///]-----
+	var slider rl.Rectangle
+
+	// Normalize value
+	if value > maxValue {
+		value = maxValue
+	}
+	if value < minValue {
+		value = minValue
+	}
+
+	_range := maxValue - minValue
+	sliderSize := int(ctx.GetStyle(ScrollBarControl, ScrollSliderSize))
+
+	// Calculate rectangles for all of the components
+	arrowUpLeft = rl.Rectangle{
+		bounds.X + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)),
+		bounds.Y + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)),
+		float32(spinnerSize),
+		float32(spinnerSize),
+	}
+
+	if isVertical {
+		arrowDownRight = rl.Rectangle{bounds.X + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Y + bounds.Height - float32(spinnerSize) - float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)), float32(spinnerSize), float32(spinnerSize)}
+		scrollbar = rl.Rectangle{bounds.X + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ScrollBarControl, ScrollPadding)), arrowUpLeft.Y + arrowUpLeft.Height, bounds.Width - 2*(float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp))+float32(ctx.GetStyle(ScrollBarControl, ScrollPadding))), bounds.Height - arrowUpLeft.Height - arrowDownRight.Height - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp))}
+		if float32(sliderSize) >= scrollbar.Height {
+			sliderSize = int(scrollbar.Height) - 2 // Make sure the slider won't get outside of the scrollbar
+		}
+		slider = rl.Rectangle{bounds.X + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ScrollBarControl, ScrollSliderPadding)), scrollbar.Y + floor32((float32(value-minValue)/float32(_range))*(scrollbar.Height-float32(sliderSize))), bounds.Width - 2*(float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp))+float32(ctx.GetStyle(ScrollBarControl, ScrollSliderPadding))), float32(sliderSize)}
+	} else {
+		arrowDownRight = rl.Rectangle{bounds.X + bounds.Width - float32(spinnerSize) - float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Y + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)), float32(spinnerSize), float32(spinnerSize)}
+		scrollbar = rl.Rectangle{arrowUpLeft.X + arrowUpLeft.Width, bounds.Y + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ScrollBarControl, ScrollPadding)), bounds.Width - arrowUpLeft.Width - arrowDownRight.Width - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp)), bounds.Height - 2*(float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp))+float32(ctx.GetStyle(ScrollBarControl, ScrollPadding)))}
+		if float32(sliderSize) >= scrollbar.Width {
+			sliderSize = int(scrollbar.Width) - 2 // Make sure the slider won't get outside of the scrollbar
+		}
+		slider = rl.Rectangle{scrollbar.X + floor32((float32(value-minValue)/float32(_range))*(scrollbar.Width-float32(sliderSize))), bounds.Y + float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp)) + float32(ctx.GetStyle(ScrollBarControl, ScrollSliderPadding)), float32(sliderSize), bounds.Height - 2*(float32(ctx.GetStyle(ScrollBarControl, BorderWidthProp))+float32(ctx.GetStyle(ScrollBarControl, ScrollSliderPadding)))}
+	}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if (state != StateDisabled) && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if rl.CheckCollisionPointRec(mousePoint, bounds) {
+			state = StateFocused
+
+			// Handle mouse wheel
+			wheel := int(ctx.backend.GetMouseWheelMove())
+			if wheel != 0 {
+				value += wheel
+			}
+
+			if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+				if rl.CheckCollisionPointRec(mousePoint, arrowUpLeft) {
+					value -= _range / int(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
+				} else if rl.CheckCollisionPointRec(mousePoint, arrowDownRight) {
+					value += _range / int(ctx.GetStyle(ScrollBarControl, ScrollSpeed))
+				}
+
+				state = StatePressed
+			} else if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				if !isVertical {
+					scrollArea := rl.Rectangle{arrowUpLeft.X + arrowUpLeft.Width, arrowUpLeft.Y, scrollbar.Width, bounds.Height - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp))}
+					if rl.CheckCollisionPointRec(mousePoint, scrollArea) {
+						value = int(((mousePoint.X-scrollArea.X-slider.Width/2)*float32(_range))/(scrollArea.Width-slider.Width) + float32(minValue))
+					}
+				} else {
+					scrollArea := rl.Rectangle{arrowUpLeft.X, arrowUpLeft.Y + arrowUpLeft.Height, bounds.Width - float32(2*ctx.GetStyle(ScrollBarControl, BorderWidthProp)), scrollbar.Height}
+					if rl.CheckCollisionPointRec(mousePoint, scrollArea) {
+						value = int(((mousePoint.Y-scrollArea.Y-slider.Height/2)*float32(_range))/(scrollArea.Height-slider.Height) + float32(minValue))
+					}
+				}
+			}
+		}
+
+		// Check keyboard focus, arrow keys scroll as arrow buttons do
+		if ctx.focusControl(ScrollBarControl, bounds) {
+			if state == StateNormal {
+				state = StateFocused
+			}
+
+			step := 1
+			if speed := int(ctx.GetStyle(ScrollBarControl, ScrollSpeed)); speed > 0 && _range/speed > 1 {
+				step = _range / speed
+			}
+			value += ctx.focusStep() * step
+		}
+
+		// Normalize value
+		if value > maxValue {
+			value = maxValue
+		}
+		if value < minValue {
+			value = minValue
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(ScrollBarControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(Default, BorderColorDisabledProp))), ctx.alpha)) // Draw the background
+
+	ctx.DrawRectangle(scrollbar, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, BaseColorNormalProp))), ctx.alpha))          // Draw the scrollbar active area background
+	ctx.DrawRectangle(slider, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(SliderControl, Border+ControlProperty(state)*3))), ctx.alpha)) // Draw the slider bar
+
+	// Draw arrows
+	padding := (spinnerSize - int(ctx.GetStyle(ScrollBarControl, ArrowsSize))) / 2
+	lineCoords := []rl.Vector2{
+		// Coordinates for <     0,1,2
+		{arrowUpLeft.X + float32(padding), arrowUpLeft.Y + float32(spinnerSize/2)},
+		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(padding)},
+		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
+
+		// Coordinates for >     3,4,5
+		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(padding)},
+		{arrowDownRight.X + float32(spinnerSize) - float32(padding), arrowDownRight.Y + float32(spinnerSize/2)},
+		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(spinnerSize) - float32(padding)},
+
+		// Coordinates for ∧     6,7,8
+		{arrowUpLeft.X + float32(spinnerSize/2), arrowUpLeft.Y + float32(padding)},
+		{arrowUpLeft.X + float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
+		{arrowUpLeft.X + float32(spinnerSize) - float32(padding), arrowUpLeft.Y + float32(spinnerSize) - float32(padding)},
+
+		// Coordinates for ∨     9,10,11
+		{arrowDownRight.X + float32(padding), arrowDownRight.Y + float32(padding)},
+		{arrowDownRight.X + float32(spinnerSize/2), arrowDownRight.Y + float32(spinnerSize) - float32(padding)},
+		{arrowDownRight.X + float32(spinnerSize) - float32(padding), arrowDownRight.Y + float32(padding)},
+	}
+
+	lineColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(ButtonControl, Text+ControlProperty(state)*3))), ctx.alpha)
+
+	if ctx.GetStyle(ScrollBarControl, ArrowsVisible) > 0 {
+		if isVertical {
+			ctx.backend.DrawTriangle(lineCoords[6], lineCoords[7], lineCoords[8], lineColor)
+			ctx.backend.DrawTriangle(lineCoords[9], lineCoords[10], lineCoords[11], lineColor)
+		} else {
+			ctx.backend.DrawTriangle(lineCoords[2], lineCoords[1], lineCoords[0], lineColor)
+			ctx.backend.DrawTriangle(lineCoords[5], lineCoords[4], lineCoords[3], lineColor)
+		}
+	}
+	//--------------------------------------------------------------------
+
+	return value
+}
+
+// List View control, returns selected list item index
+func (ctx *Context) ListView(bounds rl.Rectangle, text string, scrollIndex *int, active int) int {
+	var items []string
+
+	if text != "" {
+		itemCount := 0
+		items = ctx.TextSplit(text, &itemCount, nil)
+	}
+
+	return ctx.ListViewEx(bounds, items, nil, scrollIndex, active)
+}
+
+// List View control with extended parameters
+//
+// NOTE(port): Items are taken as a slice instead of a pointer and count, so
+// lists are not limited to the number of elements supported by TextSplit.
+func (ctx *Context) ListViewEx(bounds rl.Rectangle, text []string, focus, scrollIndex *int, active int) int {
+	state := ctx.state
+	itemFocused := -1
+	if focus != nil {
+		itemFocused = *focus
+	}
+	itemSelected := active
+	count := len(text)
+
+	// Check if we need a scroll bar
+	useScrollBar := false
+	if int(ctx.GetStyle(ListViewControl, ListItemsHeight)+ctx.GetStyle(ListViewControl, ListItemsPadding))*count > int(bounds.Height) {
+		useScrollBar = true
+	}
+
+	// Define base item rectangle [0]
+	itemBounds := rl.Rectangle{
+		X:      bounds.X + float32(ctx.GetStyle(ListViewControl, ListItemsPadding)),
+		Y:      bounds.Y + float32(ctx.GetStyle(ListViewControl, ListItemsPadding)) + float32(ctx.GetStyle(Default, BorderWidthProp)),
+		Width:  bounds.Width - 2*float32(ctx.GetStyle(ListViewControl, ListItemsPadding)) - float32(ctx.GetStyle(Default, BorderWidthProp)),
+		Height: float32(ctx.GetStyle(ListViewControl, ListItemsHeight)),
+	}
+	if useScrollBar {
+		itemBounds.Width -= float32(ctx.GetStyle(ListViewControl, ScrollBarWidth))
+	}
+
+	// Get items on the list
+	visibleItems := int(bounds.Height) / int(ctx.GetStyle(ListViewControl, ListItemsHeight)+ctx.GetStyle(ListViewControl, ListItemsPadding))
+	if visibleItems > count {
+		visibleItems = count
+	}
+
+	startIndex := 0
+	if scrollIndex != nil {
+		startIndex = *scrollIndex
+	}
+	if startIndex < 0 || startIndex > count-visibleItems {
+		startIndex = 0
+	}
+	endIndex := startIndex + visibleItems
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		// Check mouse inside list view
+		if rl.CheckCollisionPointRec(mousePoint, bounds) {
+			state = StateFocused
+
+			// Check focused and selected item
+			for i := 0; i < visibleItems; i++ {
+				if rl.CheckCollisionPointRec(mousePoint, itemBounds) {
+					itemFocused = startIndex + i
+					if ctx.backend.IsMouseButtonPressed(rl.MouseLeftButton) {
+						if itemSelected == startIndex+i {
+							itemSelected = -1
+						} else {
+							itemSelected = startIndex + i
+						}
+					}
+					break
+				}
+
+				// Update item rectangle y position for next item
+				itemBounds.Y += float32(ctx.GetStyle(ListViewControl, ListItemsHeight) + ctx.GetStyle(ListViewControl, ListItemsPadding))
+			}
+
+			if useScrollBar {
+				wheelMove := int(ctx.backend.GetMouseWheelMove())
+				startIndex -= wheelMove
+
+				if startIndex < 0 {
+					startIndex = 0
+				} else if startIndex > count-visibleItems {
+					startIndex = count - visibleItems
+				}
+
+				endIndex = startIndex + visibleItems
+				if endIndex > count {
+					endIndex = count
+				}
+			}
+		} else {
+			itemFocused = -1
+		}
+
+		// Reset item rectangle y to [0]
+		itemBounds.Y = bounds.Y + float32(ctx.GetStyle(ListViewControl, ListItemsPadding)) + float32(ctx.GetStyle(Default, BorderWidthProp))
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	ctx.DrawRectangle(bounds, int(ctx.GetStyle(Default, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.GetColor(int32(ctx.GetStyle(Default, BackgroundColorProp)))) // Draw background
+
+	// Draw visible items
+	for i := 0; i < visibleItems && text != nil; i++ {
+		if state == StateDisabled {
+			if startIndex+i == itemSelected {
+				ctx.DrawRectangle(itemBounds, int(ctx.GetStyle(ListViewControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BorderColorDisabledProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BaseColorDisabledProp))), ctx.alpha))
+			}
+
+			ctx.DrawText(text[startIndex+i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(ListViewControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, TextColorDisabledProp))), ctx.alpha))
+		} else {
+			if startIndex+i == itemSelected {
+				// Draw item selected
+				ctx.DrawRectangle(itemBounds, int(ctx.GetStyle(ListViewControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BorderColorPressedProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BaseColorPressedProp))), ctx.alpha))
+				ctx.DrawText(text[startIndex+i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(ListViewControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, TextColorPressedProp))), ctx.alpha))
+			} else if startIndex+i == itemFocused {
+				// Draw item focused
+				ctx.DrawRectangle(itemBounds, int(ctx.GetStyle(ListViewControl, BorderWidthProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BorderColorFocusedProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, BaseColorFocusedProp))), ctx.alpha))
+				ctx.DrawText(text[startIndex+i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(ListViewControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, TextColorFocusedProp))), ctx.alpha))
+			} else {
+				// Draw item normal
+				ctx.DrawText(text[startIndex+i], ctx.GetTextBounds(Default, itemBounds), TextAlignment(ctx.GetStyle(ListViewControl, TextAlignmentProp)), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ListViewControl, TextColorNormalProp))), ctx.alpha))
+			}
+		}
+
+		// Update item rectangle y position for next item
+		itemBounds.Y += float32(ctx.GetStyle(ListViewControl, ListItemsHeight) + ctx.GetStyle(ListViewControl, ListItemsPadding))
+	}
+
+	if useScrollBar {
+		scrollBarBounds := rl.Rectangle{
+			X:      bounds.X + bounds.Width - float32(ctx.GetStyle(ListViewControl, BorderWidthProp)) - float32(ctx.GetStyle(ListViewControl, ScrollBarWidth)),
+			Y:      bounds.Y + float32(ctx.GetStyle(ListViewControl, BorderWidthProp)),
+			Width:  float32(ctx.GetStyle(ListViewControl, ScrollBarWidth)),
+			Height: bounds.Height - 2*float32(ctx.GetStyle(Default, BorderWidthProp)),
+		}
+
+		// Calculate percentage of visible items and apply same percentage to scrollbar
+		percentVisible := float32(endIndex-startIndex) / float32(count)
+		sliderSize := bounds.Height * percentVisible
+
+		prevSliderSize := ctx.GetStyle(ScrollBarControl, ScrollSliderSize)    // Save default slider size
+		prevScrollSpeed := ctx.GetStyle(ScrollBarControl, ScrollSpeed)        // Save default scroll speed
+		ctx.SetStyle(ScrollBarControl, ScrollSliderSize, uint(sliderSize))    // Change slider size
+		ctx.SetStyle(ScrollBarControl, ScrollSpeed, uint(count-visibleItems)) // Change scroll speed
+
+		startIndex = ctx.ScrollBar(scrollBarBounds, startIndex, 0, count-visibleItems)
+
+		ctx.SetStyle(ScrollBarControl, ScrollSpeed, prevScrollSpeed)     // Reset scroll speed to default
+		ctx.SetStyle(ScrollBarControl, ScrollSliderSize, prevSliderSize) // Reset slider size to default
+	}
+	//--------------------------------------------------------------------
+
+	if focus != nil {
+		*focus = itemFocused
+	}
+	if scrollIndex != nil {
+		*scrollIndex = startIndex
+	}
+
+	return itemSelected
+}
+
+// Color Panel control
+func (ctx *Context) ColorPanelEx(bounds rl.Rectangle, color rl.Color, hue float32) rl.Color {
+	state := ctx.state
+
+	vcolor := rl.Vector3{float32(color.R) / 255, float32(color.G) / 255, float32(color.B) / 255}
+	hsv := ConvertRGBtoHSV(vcolor)
+
+	pickerSelector := rl.Vector2{
+		X: bounds.X + hsv.Y*bounds.Width,      // HSV: Saturation
+		Y: bounds.Y + (1-hsv.Z)*bounds.Height, // HSV: Value
+	}
+
+	maxHue := rl.Vector3{hsv.X, 1, 1}
+	if hue >= 0 {
+		maxHue.X = hue
+	}
+	rgbHue := ConvertHSVtoRGB(maxHue)
+	maxHueCol := rl.Color{uint8(255 * rgbHue.X), uint8(255 * rgbHue.Y), uint8(255 * rgbHue.Z), 255}
+
+	colWhite := rl.Color{255, 255, 255, 255}
+	colBlack := rl.Color{0, 0, 0, 255}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if rl.CheckCollisionPointRec(mousePoint, bounds) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				state = StatePressed
+				pickerSelector = mousePoint
+
+				// Calculate color from picker
+				colorPick := rl.Vector2{pickerSelector.X - bounds.X, pickerSelector.Y - bounds.Y}
+
+				colorPick.X /= bounds.Width  // Get normalized value on x
+				colorPick.Y /= bounds.Height // Get normalized value on y
+
+				hsv.Y = colorPick.X
+				hsv.Z = 1 - colorPick.Y
+
+				rgb := ConvertHSVtoRGB(hsv)
+
+				color = rl.Color{uint8(255 * rgb.X), uint8(255 * rgb.Y), uint8(255 * rgb.Z), color.A}
+			} else {
+				state = StateFocused
+			}
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	if state != StateDisabled {
+		ctx.backend.DrawRectangleGradientEx(bounds, rl.Fade(colWhite, ctx.alpha), rl.Fade(colWhite, ctx.alpha), rl.Fade(maxHueCol, ctx.alpha), rl.Fade(maxHueCol, ctx.alpha))
+		ctx.backend.DrawRectangleGradientEx(bounds, rl.Fade(colBlack, 0), rl.Fade(colBlack, ctx.alpha), rl.Fade(colBlack, ctx.alpha), rl.Fade(colBlack, 0))
+
+		// Draw color picker: selector
+		selector := rl.Rectangle{
+			X:      pickerSelector.X - float32(ctx.GetStyle(ColorPickerControl, ColorSelectorSize)/2),
+			Y:      pickerSelector.Y - float32(ctx.GetStyle(ColorPickerControl, ColorSelectorSize)/2),
+			Width:  float32(ctx.GetStyle(ColorPickerControl, ColorSelectorSize)),
+			Height: float32(ctx.GetStyle(ColorPickerControl, ColorSelectorSize)),
+		}
+		ctx.DrawRectangle(selector, 0, rl.Blank, rl.Fade(colWhite, ctx.alpha))
+	} else {
+		ctx.backend.DrawRectangleGradientEx(bounds, rl.Fade(rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BaseColorDisabledProp))), 0.1), ctx.alpha), rl.Fade(rl.Fade(colBlack, 0.6), ctx.alpha), rl.Fade(rl.Fade(colBlack, 0.6), ctx.alpha), rl.Fade(rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BorderColorDisabledProp))), 0.6), ctx.alpha))
+	}
+
+	ctx.DrawRectangle(bounds, 1, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Blank)
+	//--------------------------------------------------------------------
+
+	return color
+}
+
+// Color Panel control, hue is taken from the color
+func (ctx *Context) ColorPanel(bounds rl.Rectangle, color rl.Color) rl.Color {
+	return ctx.ColorPanelEx(bounds, color, -1)
+}
+
+const ColorBarAlphaCheckedSize = 10
+
+// Color Bar Alpha control
+// NOTE: Returns alpha value normalized [0..1]
+func (ctx *Context) ColorBarAlpha(bounds rl.Rectangle, alpha float32) float32 {
+	state := ctx.state
+	selector := rl.Rectangle{
+		X:      bounds.X + alpha*bounds.Width - float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)),
+		Y:      bounds.Y - float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)),
+		Width:  float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorHeight)),
+		Height: bounds.Height + float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)*2),
+	}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if rl.CheckCollisionPointRec(mousePoint, bounds) || rl.CheckCollisionPointRec(mousePoint, selector) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				state = StatePressed
+				selector.X = mousePoint.X - selector.Width/2
+
+				alpha = (mousePoint.X - bounds.X) / bounds.Width
+				if alpha <= 0 {
+					alpha = 0
+				}
+				if alpha >= 1 {
+					alpha = 1
+				}
+			} else {
+				state = StateFocused
+			}
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+
+	// Draw alpha bar: checked background
+	if state != StateDisabled {
+		checksX := int(bounds.Width) / ColorBarAlphaCheckedSize
+		checksY := int(bounds.Height) / ColorBarAlphaCheckedSize
+
+		for x := 0; x < checksX; x++ {
+			for y := 0; y < checksY; y++ {
+				check := rl.Rectangle{bounds.X + float32(x*ColorBarAlphaCheckedSize), bounds.Y + float32(y*ColorBarAlphaCheckedSize), ColorBarAlphaCheckedSize, ColorBarAlphaCheckedSize}
+				checkColorProp := BaseColorDisabledProp
+				if (x+y)%2 != 0 {
+					checkColorProp = BorderColorDisabledProp
+				}
+				ctx.DrawRectangle(check, 0, rl.Blank, rl.Fade(rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, checkColorProp))), 0.4), ctx.alpha))
+			}
+		}
+
+		ctx.backend.DrawRectangleGradientEx(bounds, rl.Color{255, 255, 255, 0}, rl.Color{255, 255, 255, 0}, rl.Fade(rl.Color{0, 0, 0, 255}, ctx.alpha), rl.Fade(rl.Color{0, 0, 0, 255}, ctx.alpha))
+	} else {
+		ctx.backend.DrawRectangleGradientEx(bounds, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BaseColorDisabledProp))), 0.1), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BaseColorDisabledProp))), 0.1), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BorderColorDisabledProp))), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BorderColorDisabledProp))), ctx.alpha))
+	}
+
+	ctx.DrawRectangle(bounds, 1, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Blank)
+
+	// Draw alpha bar: selector
+	ctx.DrawRectangle(selector, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, Border+ControlProperty(state)*3))), ctx.alpha))
+	//--------------------------------------------------------------------
+
+	return alpha
+}
+
+// Color Bar Hue control
+// NOTE: Returns hue value in degrees [0..360]
+func (ctx *Context) ColorBarHue(bounds rl.Rectangle, hue float32) float32 {
+	state := ctx.state
+	selector := rl.Rectangle{
+		X:      bounds.X - float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)),
+		Y:      bounds.Y + hue/360*bounds.Height - float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)),
+		Width:  bounds.Width + float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow)*2),
+		Height: float32(ctx.GetStyle(ColorPickerControl, HueBarSelectorHeight)),
+	}
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		mousePoint := ctx.backend.GetMousePosition()
+
+		if rl.CheckCollisionPointRec(mousePoint, bounds) || rl.CheckCollisionPointRec(mousePoint, selector) {
+			if ctx.backend.IsMouseButtonDown(rl.MouseLeftButton) {
+				state = StatePressed
+				selector.Y = mousePoint.Y - selector.Height/2
+
+				hue = (mousePoint.Y - bounds.Y) * 360 / bounds.Height
+				if hue <= 0 {
+					hue = 0
+				}
+				if hue >= 359 {
+					hue = 359
+				}
+			} else {
+				state = StateFocused
+			}
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	if state != StateDisabled {
+		// Draw hue bar: color bars
+		overflow := int32(ctx.GetStyle(ColorPickerControl, HueBarSelectorOverflow))
+		sectionHeight := int32(bounds.Height) / 6
+		hueColors := []rl.Color{
+			{255, 0, 0, 255},
+			{255, 255, 0, 255},
+			{0, 255, 0, 255},
+			{0, 255, 255, 255},
+			{0, 0, 255, 255},
+			{255, 0, 255, 255},
+			{255, 0, 0, 255},
+		}
+		for i := int32(0); i < 6; i++ {
+			height := sectionHeight
+			if i == 5 {
+				height -= overflow
+			}
+			ctx.backend.DrawRectangleGradientV(int32(bounds.X)+overflow/2, int32(bounds.Y)+i*sectionHeight+overflow/2, int32(bounds.Width)-overflow, height, rl.Fade(hueColors[i], ctx.alpha), rl.Fade(hueColors[i+1], ctx.alpha))
+		}
+	} else {
+		ctx.backend.DrawRectangleGradientV(int32(bounds.X), int32(bounds.Y), int32(bounds.Width), int32(bounds.Height), rl.Fade(rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BaseColorDisabledProp))), 0.1), ctx.alpha), rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, BorderColorDisabledProp))), ctx.alpha))
+	}
+
+	ctx.DrawRectangle(bounds, 1, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, Border+ControlProperty(state)*3))), ctx.alpha), rl.Blank)
+
+	// Draw hue bar: selector
+	ctx.DrawRectangle(selector, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(ColorPickerControl, Border+ControlProperty(state)*3))), ctx.alpha))
+	//--------------------------------------------------------------------
+
+	return hue
+}
+
+// Color Picker control
+// NOTE: It's divided in multiple controls: ColorPanel(), ColorBarAlpha() and ColorBarHue()
+// NOTE: bounds define ColorPanel() size
+func (ctx *Context) ColorPicker(bounds rl.Rectangle, color rl.Color) rl.Color {
+	color = ctx.ColorPanel(bounds, color)
+
+	boundsHue := rl.Rectangle{bounds.X + bounds.Width + float32(ctx.GetStyle(ColorPickerControl, HueBarPadding)), bounds.Y, float32(ctx.GetStyle(ColorPickerControl, HueBarWidth)), bounds.Height}
+
+	hsv := ConvertRGBtoHSV(rl.Vector3{float32(color.R) / 255, float32(color.G) / 255, float32(color.B) / 255})
+	hsv.X = ctx.ColorBarHue(boundsHue, hsv.X)
+	rgb := ConvertHSVtoRGB(hsv)
+	color = rl.Color{uint8(round32(rgb.X * 255)), uint8(round32(rgb.Y * 255)), uint8(round32(rgb.Z * 255)), color.A}
+
+	return color
+}
+
+const MessageBoxButtonHeight = 24
+const MessageBoxButtonPadding = 10
+
+// Message Box control, displays a message
+// NOTE: Returns clicked button from buttons list (starting at 1), 0 refers to
+// closed window button and -1 means nothing was clicked
+func (ctx *Context) MessageBox(bounds rl.Rectangle, title, message, buttons string) int {
+	clicked := -1 // Returns clicked button from buttons list, 0 refers to closed window button
+
+	buttonCount := 0
+	buttonsText := ctx.TextSplit(buttons, &buttonCount, nil)
+	buttonBounds := rl.Rectangle{
+		X:      bounds.X + MessageBoxButtonPadding,
+		Y:      bounds.Y + bounds.Height - MessageBoxButtonHeight - MessageBoxButtonPadding,
+		Width:  (bounds.Width - MessageBoxButtonPadding*float32(buttonCount+1)) / float32(buttonCount),
+		Height: MessageBoxButtonHeight,
+	}
+
+	textSize := ctx.backend.MeasureTextEx(ctx.font, message, float32(ctx.GetStyle(Default, TextSizeProp)), 1)
+
+	textBounds := rl.Rectangle{
+		X:      bounds.X + bounds.Width/2 - textSize.X/2,
+		Y:      bounds.Y + WindowStatusBarHeight + (bounds.Height-WindowStatusBarHeight-MessageBoxButtonHeight-MessageBoxButtonPadding)/2 - textSize.Y/2,
+		Width:  textSize.X,
+		Height: textSize.Y,
+	}
+
+	// Draw control
+	//--------------------------------------------------------------------
+	if ctx.WindowBox(bounds, title) {
+		clicked = 0
+	}
+
+	prevTextAlignment := ctx.GetStyle(LabelControl, TextAlignmentProp)
+	ctx.SetStyle(LabelControl, TextAlignmentProp, uint(TextAlignCenter))
+	ctx.Label(textBounds, message)
+	ctx.SetStyle(LabelControl, TextAlignmentProp, prevTextAlignment)
+
+	prevTextAlignment = ctx.GetStyle(ButtonControl, TextAlignmentProp)
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
+
+	for i := 0; i < buttonCount; i++ {
+		if ctx.Button(buttonBounds, buttonsText[i]) {
+			clicked = i + 1
+		}
+		buttonBounds.X += buttonBounds.Width + MessageBoxButtonPadding
+	}
+
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, prevTextAlignment)
+	//--------------------------------------------------------------------
+
+	return clicked
+}
+
+const TextInputBoxButtonHeight = 24
+const TextInputBoxButtonPadding = 10
+const TextInputBoxHeight = 30
+
+const TextInputBoxMaxTextLength = 256
+
+// Text Input Box control, ask for text
+// NOTE: Returns clicked button from buttons list (starting at 1), 0 refers to
+// closed window button and -1 means nothing was clicked
+//
+// NOTE(port): The signature of this method is different because of differences
+// in how strings work between C and Go. An empty string is used in place of NULL
+// for message.
+func (ctx *Context) TextInputBox(bounds rl.Rectangle, title, message, buttons, text string) (string, int) {
+	btnIndex := -1
+
+	buttonCount := 0
+	buttonsText := ctx.TextSplit(buttons, &buttonCount, nil)
+	buttonBounds := rl.Rectangle{
+		X:      bounds.X + TextInputBoxButtonPadding,
+		Y:      bounds.Y + bounds.Height - TextInputBoxButtonHeight - TextInputBoxButtonPadding,
+		Width:  (bounds.Width - TextInputBoxButtonPadding*float32(buttonCount+1)) / float32(buttonCount),
+		Height: TextInputBoxButtonHeight,
+	}
+
+	messageInputHeight := int(bounds.Height) - WindowStatusBarHeight - int(ctx.GetStyle(StatusBarControl, BorderWidthProp)) - TextInputBoxButtonHeight - 2*TextInputBoxButtonPadding
+
+	var textBounds rl.Rectangle
+	if message != "" {
+		textSize := ctx.backend.MeasureTextEx(ctx.font, message, float32(ctx.GetStyle(Default, TextSizeProp)), 1)
+
+		textBounds.X = bounds.X + bounds.Width/2 - textSize.X/2
+		textBounds.Y = bounds.Y + WindowStatusBarHeight + float32(messageInputHeight/4) - textSize.Y/2
+		textBounds.Width = textSize.X
+		textBounds.Height = textSize.Y
+	}
+
+	textBoxBounds := rl.Rectangle{
+		X:      bounds.X + TextInputBoxButtonPadding,
+		Y:      bounds.Y + WindowStatusBarHeight - TextInputBoxHeight/2,
+		Width:  bounds.Width - TextInputBoxButtonPadding*2,
+		Height: TextInputBoxHeight,
+	}
+	if message == "" {
+		textBoxBounds.Y += float32(messageInputHeight / 2)
+	} else {
+		textBoxBounds.Y += float32(messageInputHeight/2 + messageInputHeight/4)
+	}
+
+	// Draw control
+	//--------------------------------------------------------------------
+	if ctx.WindowBox(bounds, title) {
+		btnIndex = 0
+	}
+
+	// Draw message if available
+	if message != "" {
+		prevTextAlignment := ctx.GetStyle(LabelControl, TextAlignmentProp)
+		ctx.SetStyle(LabelControl, TextAlignmentProp, uint(TextAlignCenter))
+		ctx.Label(textBounds, message)
+		ctx.SetStyle(LabelControl, TextAlignmentProp, prevTextAlignment)
+	}
+
+	var toggleEditMode bool
+	if text, toggleEditMode = ctx.TextBox(textBoxBounds, text, TextInputBoxMaxTextLength, ctx.textInputBoxEditMode); toggleEditMode {
+		ctx.textInputBoxEditMode = !ctx.textInputBoxEditMode
+	}
+
+	prevBtnTextAlignment := ctx.GetStyle(ButtonControl, TextAlignmentProp)
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignCenter))
+
+	for i := 0; i < buttonCount; i++ {
+		if ctx.Button(buttonBounds, buttonsText[i]) {
+			btnIndex = i + 1
+		}
+		buttonBounds.X += buttonBounds.Width + MessageBoxButtonPadding
+	}
+
+	ctx.SetStyle(ButtonControl, TextAlignmentProp, prevBtnTextAlignment)
+	//--------------------------------------------------------------------
+
+	return text, btnIndex
+}
+
+const GridColorAlpha = 0.15 // Grid lines alpha amount
+
+// Grid control
+// NOTE: Returns grid mouse-hover selected cell, or {-1, -1} when the mouse is
+// outside the grid. Every spacing pixels a major line is drawn, split by
+// subdivs minor lines, both using the LINE_COLOR property at different alphas.
+// About drawing lines at subpixel spacing, simple put, not easy solution:
+// https://stackoverflow.com/questions/4435450/2d-opengl-drawing-lines-that-dont-exactly-fit-pixel-raster
+//
+// NOTE(port): The original C returns the fractional position of the mouse in
+// cells, here it is floored to the index of the hovered cell. Nothing is drawn
+// and {-1, -1} is returned when spacing <= 0, like GridSnap() ignores it.
+func (ctx *Context) Grid(bounds rl.Rectangle, spacing float32, subdivs int) rl.Vector2 {
+	state := ctx.state
+	mousePoint := ctx.backend.GetMousePosition()
+	currentCell := rl.Vector2{-1, -1}
+
+	if spacing <= 0 {
+		return currentCell
+	}
+
+	linesV := int(bounds.Width/spacing)*subdivs + 1
+	linesH := int(bounds.Height/spacing)*subdivs + 1
+
+	// Update control
+	//--------------------------------------------------------------------
+	if state != StateDisabled && !ctx.locked {
+		if rl.CheckCollisionPointRec(mousePoint, bounds) {
+			currentCell.X = floor32((mousePoint.X - bounds.X) / spacing)
+			currentCell.Y = floor32((mousePoint.Y - bounds.Y) / spacing)
+		}
+	}
+	//--------------------------------------------------------------------
+
+	// Draw control
+	//--------------------------------------------------------------------
+	switch state {
+	case StateNormal:
+		if subdivs > 0 {
+			majorColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(Default, LineColorProp))), GridColorAlpha*4*ctx.alpha)
+			minorColor := rl.Fade(rl.GetColor(int32(ctx.GetStyle(Default, LineColorProp))), GridColorAlpha*ctx.alpha)
+
+			// Draw vertical grid lines
+			for i := 0; i < linesV; i++ {
+				lineV := rl.Rectangle{bounds.X + spacing*float32(i)/float32(subdivs), bounds.Y, 1, bounds.Height}
+				if i%subdivs == 0 {
+					ctx.DrawRectangle(lineV, 0, rl.Blank, majorColor)
+				} else {
+					ctx.DrawRectangle(lineV, 0, rl.Blank, minorColor)
+				}
+			}
+
+			// Draw horizontal grid lines
+			for i := 0; i < linesH; i++ {
+				lineH := rl.Rectangle{bounds.X, bounds.Y + spacing*float32(i)/float32(subdivs), bounds.Width, 1}
+				if i%subdivs == 0 {
+					ctx.DrawRectangle(lineH, 0, rl.Blank, majorColor)
+				} else {
+					ctx.DrawRectangle(lineH, 0, rl.Blank, minorColor)
+				}
+			}
+		}
+	}
+	//--------------------------------------------------------------------
+
+	return currentCell
+}
+
+// Snap a point to the nearest line intersection of a grid drawn with Grid()
+// NOTE: Minor lines are considered when subdivs > 0, otherwise only major lines.
+// Result is kept inside the last major lines drawn, a whole number of spacings
+// from bounds origin. Point is returned unchanged when spacing <= 0
+func GridSnap(bounds rl.Rectangle, spacing float32, subdivs int, point rl.Vector2) rl.Vector2 {
+	if spacing <= 0 {
+		return point
+	}
+
+	step := spacing
+	if subdivs > 0 {
+		step = spacing / float32(subdivs)
+	}
+
+	// Last lines drawn by Grid(), int(bounds.Width/spacing)*subdivs lines after the first
+	maxX := float32(int(bounds.Width/spacing)) * spacing
+	maxY := float32(int(bounds.Height/spacing)) * spacing
+
+	return rl.Vector2{
+		X: bounds.X + clampFloat(round32((point.X-bounds.X)/step)*step, 0, maxX),
+		Y: bounds.Y + clampFloat(round32((point.Y-bounds.Y)/step)*step, 0, maxY),
+	}
+}
+
+// Binary style file (.rgs) signature
+const rgsSignature = "rGS "
+
+// Binary style file limits, checked before any data is allocated
+const (
+	rgsMaxPropertyCount = MaxControls * (MaxPropsDefault + MaxPropsExtended) // Every property of every control
+	rgsMaxGlyphCount    = 0x10000                                            // Glyphs in font atlas
+	rgsMaxFontImageSize = 4096 * 4096 * 4                                    // Bytes of font atlas image, 4096x4096 RGBA
+)
+
+// Load raygui style file (.rgs) over global style
+// NOTE: Fonts referenced by text style files are loaded relative to the style file directory
+func (ctx *Context) LoadStyle(fileName string) error {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := ctx.loadStyle(file, filepath.Dir(fileName)); err != nil {
+		return fmt.Errorf("raygui: loading style %s: %w", fileName, err)
+	}
+	return nil
+}
+
+// Load raygui style (.rgs) from reader over global style, text and binary formats are supported
+// NOTE(port): Fonts referenced by text style files are loaded relative to the working directory
+func (ctx *Context) LoadStyleFromReader(r io.Reader) error {
+	if err := ctx.loadStyle(r, "."); err != nil {
+		return fmt.Errorf("raygui: loading style: %w", err)
+	}
+	return nil
+}
+
+func (ctx *Context) loadStyle(r io.Reader, dir string) error {
+	br := bufio.NewReader(r)
+
+	// Text style files always start with a comment line, anything else is tried as binary
+	first, err := br.Peek(1)
+	if err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	if first[0] == '#' {
+		return ctx.loadStyleText(br, dir)
+	}
+	return ctx.loadStyleBinary(br)
+}
+
+// Check style property ids read from a style file before they index the global style
+func checkStyleProperty(controlId, propertyId int) error {
+	if controlId < 0 || controlId >= MaxControls {
+		return fmt.Errorf("invalid control id %d", controlId)
+	}
+	if propertyId < 0 || propertyId >= MaxPropsDefault+MaxPropsExtended {
+		return fmt.Errorf("invalid property id %d", propertyId)
+	}
+	return nil
+}
+
+// Load text style file: one property or font per line
+// NOTE: The whole file is parsed and the font loaded before any property is applied,
+// so an invalid file leaves the global style unchanged
+func (ctx *Context) loadStyleText(r io.Reader, dir string) error {
+	var properties []rgsProperty
+	var fontLine struct {
+		number          int    // Line number, 0 when the file has no font
+		properties      int    // Properties defined before the font
+		size            int32  // Generated font size
+		charmapFileName string // Charmap file name, "0" for default characters
+		fileName        string // Font file name
+	}
+
+	scanner := bufio.NewScanner(r)
+	lineNumber := 0
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		lineNumber++
+
+		if len(line) == 0 {
+			continue
+		}
+
+		switch line[0] {
+		case 'p':
+			// Style property: p <control_id> <property_id> <property_value> <property_name>
+			var controlId, propertyId int
+			var propertyValue uint32
+			if _, err := fmt.Sscanf(line, "p %d %d 0x%x", &controlId, &propertyId, &propertyValue); err != nil {
+				return fmt.Errorf("line %d: invalid property: %w", lineNumber, err)
+			}
+			if err := checkStyleProperty(controlId, propertyId); err != nil {
+				return fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			properties = append(properties, rgsProperty{int16(controlId), int16(propertyId), int32(propertyValue)})
+		case 'f':
+			// Style font: f <gen_font_size> <charmap_file> <font_file>
+			// NOTE: Font file name is the rest of the line and may contain spaces
+			fields := strings.Fields(line)
+			if len(fields) < 4 {
+				return fmt.Errorf("line %d: invalid font: expected size, charmap and font file", lineNumber)
+			}
+			fontSize, err := strconv.ParseInt(fields[1], 10, 32)
+			if err != nil || fontSize <= 0 {
+				return fmt.Errorf("line %d: invalid font size %q", lineNumber, fields[1])
+			}
+
+			fontLine.number = lineNumber
+			fontLine.properties = len(properties)
+			fontLine.size = int32(fontSize)
+			fontLine.charmapFileName = fields[2]
+			fontLine.fileName = strings.Join(fields[3:], " ")
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	var font rl.Font
+	if fontLine.number > 0 {
+		lineNumber := fontLine.number
+
+		// NOTE(port): The C version loads the charmap relative to the working directory
+		var values []int32
+		if fontLine.charmapFileName[0] != '0' {
+			// Load characters from charmap file,
+			// expected '\n' separated list of integer values
+			charValues, err := os.ReadFile(filepath.Join(dir, fontLine.charmapFileName))
+			if err != nil {
+				return fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			for _, char := range strings.Split(string(charValues), "\n") {
+				char = strings.TrimSpace(char)
+				if char == "" {
+					continue
+				}
+				value, err := strconv.Atoi(char)
+				if err != nil {
+					return fmt.Errorf("line %d: invalid charmap value %q", lineNumber, char)
+				}
+				values = append(values, int32(value))
+			}
+		}
+
+		if len(values) > 0 {
+			font = rl.LoadFontEx(filepath.Join(dir, fontLine.fileName), fontLine.size, &values[0], int32(len(values)))
+		} else {
+			font = rl.LoadFontEx(filepath.Join(dir, fontLine.fileName), fontLine.size, nil, 0)
+		}
+
+		if font.Texture.ID == 0 || font.CharsCount == 0 {
+			return fmt.Errorf("line %d: could not load font %s", lineNumber, fontLine.fileName)
+		}
+	}
+
+	// Font is set where it was defined, since it changes the DEFAULT text size
+	if fontLine.number > 0 {
+		ctx.loadStyleProperties(properties[:fontLine.properties])
+		ctx.SetFont(font)
+		properties = properties[fontLine.properties:]
+	}
+	ctx.loadStyleProperties(properties)
+
+	return nil
+}
+
+// Binary style file header
+type rgsHeader struct {
+	Signature     [4]byte
+	Version       int16
+	Reserved      int16
+	PropertyCount int32
+}
+
+// Binary style file property
+type rgsProperty struct {
+	ControlId     int16
+	PropertyId    int16
+	PropertyValue int32
+}
+
+// Binary style file font parameters
+type rgsFontInfo struct {
+	BaseSize   int32
+	GlyphCount int32
+	FontType   int32 // 0-Normal, 1-SDF
+	WhiteRec   rl.Rectangle
+}
+
+// Binary style file font image parameters
+type rgsFontImage struct {
+	Width  int32
+	Height int32
+	Format int32
+}
+
+// Binary style file glyph info
+type rgsGlyph struct {
+	Value    int32
+	OffsetX  int32
+	OffsetY  int32
+	AdvanceX int32
+}
+
+// Get size in bytes of image data, 0 for unknown formats -- raylib GetPixelDataSize()
+func pixelDataSize(width, height int32, format rl.PixelFormat) int64 {
+	var bpp int64 // Bits per pixel
+	switch format {
+	case rl.UncompressedGrayscale:
+		bpp = 8
+	case rl.UncompressedGrayAlpha, rl.UncompressedR5g6b5, rl.UncompressedR5g5b5a1, rl.UncompressedR4g4b4a4:
+		bpp = 16
+	case rl.UncompressedR8g8b8a8, rl.UncompressedR32:
+		bpp = 32
+	case rl.UncompressedR8g8b8:
+		bpp = 24
+	case rl.UncompressedR32g32b32:
+		bpp = 96
+	case rl.UncompressedR32g32b32a32:
+		bpp = 128
+	case rl.CompressedDxt1Rgb, rl.CompressedDxt1Rgba, rl.CompressedEtc1Rgb, rl.CompressedEtc2Rgb, rl.CompressedPvrtRgb, rl.CompressedPvrtRgba:
+		bpp = 4
+	case rl.CompressedDxt3Rgba, rl.CompressedDxt5Rgba, rl.CompressedEtc2EacRgba, rl.CompressedAstc4x4Rgba:
+		bpp = 8
+	case rl.CompressedAstc8x8Rgba:
+		bpp = 2
+	default:
+		return 0
+	}
+
+	dataSize := int64(width) * int64(height) * bpp / 8
+
+	// Most compressed formats work on 4x4 blocks,
+	// if texture is smaller, minimum dataSize is 8 or 16
+	if width < 4 && height < 4 {
+		if format >= rl.CompressedDxt1Rgb && format < rl.CompressedDxt3Rgba {
+			dataSize = 8
+		} else if format >= rl.CompressedDxt3Rgba && format < rl.CompressedAstc8x8Rgba {
+			dataSize = 16
+		}
+	}
+
+	return dataSize
+}
+
+// Load binary style file: header, properties and optional font atlas
+func (ctx *Context) loadStyleBinary(r io.Reader) error {
+	var header rgsHeader
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return err
+	}
+	if string(header.Signature[:]) != rgsSignature {
+		return fmt.Errorf("invalid signature %q", header.Signature[:])
+	}
+	if header.PropertyCount < 0 || header.PropertyCount > rgsMaxPropertyCount {
+		return fmt.Errorf("invalid property count %d", header.PropertyCount)
+	}
+
+	// Properties are validated before any of them are applied
+	properties := make([]rgsProperty, header.PropertyCount)
+	if err := binary.Read(r, binary.LittleEndian, properties); err != nil {
+		return err
+	}
+	for _, p := range properties {
+		if err := checkStyleProperty(int(p.ControlId), int(p.PropertyId)); err != nil {
+			return err
+		}
+	}
+
+	// Load custom font if available
+	var fontDataSize int32
+	if err := binary.Read(r, binary.LittleEndian, &fontDataSize); err != nil {
+		// Font data is optional, older style files end right after the properties
+		if err != io.EOF {
+			return err
+		}
+		fontDataSize = 0
+	}
+	if fontDataSize <= 0 {
+		ctx.loadStyleProperties(properties)
+		return nil
+	}
+
+	var info rgsFontInfo
+	if err := binary.Read(r, binary.LittleEndian, &info); err != nil {
+		return err
+	}
+	if info.GlyphCount <= 0 || info.GlyphCount > rgsMaxGlyphCount {
+		return fmt.Errorf("invalid font glyph count %d", info.GlyphCount)
+	}
+
+	// Load font image parameters
+	var fontImageSize int32
+	if err := binary.Read(r, binary.LittleEndian, &fontImageSize); err != nil {
+		return err
+	}
+	if fontImageSize <= 0 || fontImageSize > rgsMaxFontImageSize {
+		return fmt.Errorf("invalid font image size %d", fontImageSize)
+	}
+
+	var imageInfo rgsFontImage
+	if err := binary.Read(r, binary.LittleEndian, &imageInfo); err != nil {
+		return err
+	}
+	if imageInfo.Width <= 0 || imageInfo.Height <= 0 {
+		return fmt.Errorf("invalid font image size %dx%d", imageInfo.Width, imageInfo.Height)
+	}
+
+	// Texture loading reads as many bytes as width, height and format require
+	dataSize := pixelDataSize(imageInfo.Width, imageInfo.Height, rl.PixelFormat(imageInfo.Format))
+	if dataSize == 0 {
+		return fmt.Errorf("invalid font image format %d", imageInfo.Format)
+	}
+	if int64(fontImageSize) != dataSize {
+		return fmt.Errorf("font image size %d does not match %dx%d image of format %d", fontImageSize, imageInfo.Width, imageInfo.Height, imageInfo.Format)
+	}
+	imageData := make([]byte, fontImageSize)
+	if _, err := io.ReadFull(r, imageData); err != nil {
+		return err
+	}
+
+	// Load font recs data
+	recs := make([]rl.Rectangle, info.GlyphCount)
+	if err := binary.Read(r, binary.LittleEndian, recs); err != nil {
+		return err
+	}
+
+	// Load font chars info data
+	glyphs := make([]rgsGlyph, info.GlyphCount)
+	if err := binary.Read(r, binary.LittleEndian, glyphs); err != nil {
+		return err
+	}
+	chars := make([]rl.CharInfo, info.GlyphCount)
+	for i, g := range glyphs {
+		chars[i] = rl.CharInfo{Value: g.Value, OffsetX: g.OffsetX, OffsetY: g.OffsetY, AdvanceX: g.AdvanceX}
+	}
+
+	// Properties are applied once the whole file has been read
+	ctx.loadStyleProperties(properties)
+
+	// Font loading is highly dependant on raylib API to load font data and image
+	imFont := rl.NewImage(imageData, imageInfo.Width, imageInfo.Height, 1, rl.PixelFormat(imageInfo.Format))
+	texture := rl.LoadTextureFromImage(imFont)
+	if texture.ID == 0 {
+		return fmt.Errorf("could not load font texture")
+	}
+
+	font := newFont(info.BaseSize, texture, recs, chars)
+	ctx.SetFont(font)
+
+	// Set font texture source rectangle to be used as white texture to draw shapes
+	// NOTE: This way, all gui can be draw using a single draw call
+	if info.WhiteRec.Width != 0 && info.WhiteRec.Height != 0 {
+		rl.SetShapesTexture(font.Texture, info.WhiteRec)
+	}
+
+	return nil
+}
+
+// Set style properties read from a style file
+// NOTE: DEFAULT properties are propagated to all controls by SetStyle(),
+// so all DEFAULT properties should be defined first in the file
+func (ctx *Context) loadStyleProperties(properties []rgsProperty) {
+	for _, p := range properties {
+		ctx.SetStyle(Control(p.ControlId), ControlProperty(p.PropertyId), uint(uint32(p.PropertyValue)))
+	}
+}
+
+// Style file format
+type StyleFormat int
+
+const (
+	StyleFormatText StyleFormat = iota
+	StyleFormatBinary
+)
+
+// Binary style file version
+const rgsVersion = 200
+
+// Save global style to writer as raygui style (.rgs), only properties different from default style are saved
+// NOTE: Custom font is not saved, it must be set again with SetFont() after loading
+func (ctx *Context) SaveStyle(w io.Writer, format StyleFormat) error {
+	properties := ctx.styleChanges()
+
+	bw := bufio.NewWriter(w)
+	switch format {
+	case StyleFormatText:
+		fmt.Fprintf(bw, "#\n# rgs style text file (v%d.%d) - raygui style file\n#\n", rgsVersion/100, rgsVersion%100/10)
+		fmt.Fprintf(bw, "# Style properties:\n#   p <control_id> <property_id> <property_value>\n#\n")
+		for _, p := range properties {
+			fmt.Fprintf(bw, "p %02d %02d 0x%08x\n", p.ControlId, p.PropertyId, uint32(p.PropertyValue))
+		}
+	case StyleFormatBinary:
+		header := rgsHeader{Version: rgsVersion, PropertyCount: int32(len(properties))}
+		copy(header.Signature[:], rgsSignature)
+		binary.Write(bw, binary.LittleEndian, header)
+		for _, p := range properties {
+			binary.Write(bw, binary.LittleEndian, rgsProperty{int16(p.ControlId), int16(p.PropertyId), int32(p.PropertyValue)})
+		}
+		binary.Write(bw, binary.LittleEndian, int32(0)) // No font data
+	default:
+		return fmt.Errorf("raygui: unknown style format %d", format)
+	}
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("raygui: saving style: %w", err)
+	}
+	return nil
+}
+
+// Get global style properties that differ from default style, in the order they must be loaded
+// NOTE: DEFAULT properties come first, loading them propagates their values to all controls
+func (ctx *Context) styleChanges() []GuiStyleProp {
+	if !ctx.styleLoaded {
+		ctx.LoadStyleDefault()
+	}
+
+	// Default style is loaded in a scratch context, so extended properties
+	// without default value are zero and the global style and font are kept
+	defaults := Context{backend: ctx.backend}
+	defaults.LoadStyleDefault()
+	current := ctx.style
+	loaded := defaults.style
+
+	var properties []GuiStyleProp
+	for control := 0; control < MaxControls; control++ {
+		for property := 0; property < MaxPropsDefault+MaxPropsExtended; property++ {
+			i := control*(MaxPropsDefault+MaxPropsExtended) + property
+			if loaded[i] == current[i] {
+				continue
+			}
+
+			properties = append(properties, GuiStyleProp{uint(control), uint(property), int(current[i])})
+
+			// Track the propagation done by SetStyle() when the file is loaded
+			loaded[i] = current[i]
+			if control == 0 && property < MaxPropsDefault {
+				for c := 1; c < MaxControls; c++ {
+					loaded[c*(MaxPropsDefault+MaxPropsExtended)+property] = current[i]
+				}
+			}
+		}
+	}
+
+	return properties
+}
+
+// Load style default over global style
+func (ctx *Context) LoadStyleDefault() {
+	// We set this variable first to avoid cyclic function calls
+	// when calling GuiSetStyle() and GuiGetStyle()
+	ctx.styleLoaded = true
+
+	// Initialize default LIGHT style property values
+	ctx.SetStyle(Default, BorderColorNormalProp, 0x838383ff)
+	ctx.SetStyle(Default, BaseColorNormalProp, 0xc9c9c9ff)
+	ctx.SetStyle(Default, TextColorNormalProp, 0x686868ff)
+	ctx.SetStyle(Default, BorderColorFocusedProp, 0x5bb2d9ff)
+	ctx.SetStyle(Default, BaseColorFocusedProp, 0xc9effeff)
+	ctx.SetStyle(Default, TextColorFocusedProp, 0x6c9bbcff)
+	ctx.SetStyle(Default, BorderColorPressedProp, 0x0492c7ff)
+	ctx.SetStyle(Default, BaseColorPressedProp, 0x97e8ffff)
+	ctx.SetStyle(Default, TextColorPressedProp, 0x368bafff)
+	ctx.SetStyle(Default, BorderColorDisabledProp, 0xb5c1c2ff)
+	ctx.SetStyle(Default, BaseColorDisabledProp, 0xe6e9e9ff)
+	ctx.SetStyle(Default, TextColorDisabledProp, 0xaeb7b8ff)
+	ctx.SetStyle(Default, BorderWidthProp, 1)                       // WARNING: Some controls use other values
+	ctx.SetStyle(Default, TextPaddingProp, 0)                       // WARNING: Some controls use other values
+	ctx.SetStyle(Default, TextAlignmentProp, uint(TextAlignCenter)) // WARNING: Some controls use other values
+
+	// Initialize control-specific property values
+	// NOTE: Those properties are in default list but require specific values by control type
+	ctx.SetStyle(LabelControl, TextAlignmentProp, uint(TextAlignLeft))
+	ctx.SetStyle(ButtonControl, BorderWidthProp, 2)
+	ctx.SetStyle(SliderControl, TextPaddingProp, 5)
+	ctx.SetStyle(CheckBoxControl, TextPaddingProp, 5)
+	ctx.SetStyle(CheckBoxControl, TextAlignmentProp, uint(TextAlignRight))
+	ctx.SetStyle(TextBoxControl, TextPaddingProp, 5)
+	ctx.SetStyle(TextBoxControl, TextAlignmentProp, uint(TextAlignLeft))
+	ctx.SetStyle(ValueBoxControl, TextPaddingProp, 4)
+	ctx.SetStyle(ValueBoxControl, TextAlignmentProp, uint(TextAlignLeft))
+	ctx.SetStyle(SpinnerControl, TextPaddingProp, 4)
+	ctx.SetStyle(SpinnerControl, TextAlignmentProp, uint(TextAlignLeft))
+	ctx.SetStyle(StatusBarControl, TextPaddingProp, 6)
+	ctx.SetStyle(StatusBarControl, TextAlignmentProp, uint(TextAlignLeft))
+
+	// Initialize extended property values
+	// NOTE: By default, extended property values are initialized to 0
+	ctx.SetStyle(Default, TextSizeProp, 10)                // Default, shared by all controls
+	ctx.SetStyle(Default, TextSpacingProp, 1)              // Default, shared by all controls
+	ctx.SetStyle(Default, LineColorProp, 0x90abb5ff)       // Default specific property
+	ctx.SetStyle(Default, BackgroundColorProp, 0xf5f5f5ff) // Default specific property
+	ctx.SetStyle(ToggleControl, GroupPadding, 2)
+	ctx.SetStyle(SliderControl, SliderWidth, 15)
+	ctx.SetStyle(SliderControl, SliderPadding, 1)
+	ctx.SetStyle(ProgressBarControl, ProgressPadding, 1)
+	ctx.SetStyle(CheckBoxControl, CheckPadding, 1)
+	ctx.SetStyle(ComboBoxControl, ComboButtonWidth, 30)
+	ctx.SetStyle(ComboBoxControl, ComboButtonPadding, 2)
+	ctx.SetStyle(DropdownBoxControl, ArrowPadding, 16)
+	ctx.SetStyle(DropdownBoxControl, DropdownItemsPadding, 2)
+	ctx.SetStyle(TextBoxControl, TextLinesPadding, 5)
+	ctx.SetStyle(TextBoxControl, TextInnerPadding, 4)
+	ctx.SetStyle(TextBoxControl, ColorSelectedFG, 0xf0fffeff)
+	ctx.SetStyle(TextBoxControl, ColorSelectedBG, 0x839affe0)
+	ctx.SetStyle(SpinnerControl, SpinButtonWidth, 20)
+	ctx.SetStyle(SpinnerControl, SpinButtonPadding, 2)
+	ctx.SetStyle(ValueBoxControl, FloatPrecision, 2)
+	ctx.SetStyle(ValueBoxControl, DragSpeed, 1)
+	ctx.SetStyle(ScrollBarControl, BorderWidthProp, 0)
+	ctx.SetStyle(ScrollBarControl, ArrowsVisible, 0)
+	ctx.SetStyle(ScrollBarControl, ArrowsSize, 6)
+	ctx.SetStyle(ScrollBarControl, ScrollSliderPadding, 0)
+	ctx.SetStyle(ScrollBarControl, ScrollSliderSize, 16)
+	ctx.SetStyle(ScrollBarControl, ScrollPadding, 0)
+	ctx.SetStyle(ScrollBarControl, ScrollSpeed, 10)
+	ctx.SetStyle(ListViewControl, ListItemsHeight, 0x1e)
+	ctx.SetStyle(ListViewControl, ListItemsPadding, 2)
+	ctx.SetStyle(ListViewControl, ScrollBarWidth, 10)
+	ctx.SetStyle(ListViewControl, ScrollBarSideProp, uint(ScrollBarRightSide))
+	ctx.SetStyle(ColorPickerControl, ColorSelectorSize, 6)
+	ctx.SetStyle(ColorPickerControl, HueBarWidth, 0x14)
+	ctx.SetStyle(ColorPickerControl, HueBarPadding, 0xa)
+	ctx.SetStyle(ColorPickerControl, HueBarSelectorHeight, 6)
+	ctx.SetStyle(ColorPickerControl, HueBarSelectorOverflow, 2)
+
+	ctx.font = ctx.backend.GetFontDefault() // Initialize default font
+}
+
+// Get text with icon id prepended
+// NOTE: Useful to add icons by name id (enum) instead of
+// a number that can change between ricon versions
+func IconText(icon Icon, text string) string {
+	return fmt.Sprintf("#%03d#%s", icon, text)
+}
+
+// Icons file (.rgi) signature
+const rgiSignature = "rGI "
+
+// Icons file header
+type rgiHeader struct {
+	Signature [4]byte
+	Version   int16
+	Reserved  int16
+	IconCount int16
+	IconSize  int16
+}
+
+// Load raygui icons file (.rgi) over global icons, returns the icons name ids
+// NOTE: Loaded icons set must be same RIconSize
+func (ctx *Context) LoadIcons(fileName string) ([]string, error) {
+	file, err := os.Open(fileName)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	names, err := ctx.loadIcons(file)
+	if err != nil {
+		return nil, fmt.Errorf("raygui: loading icons %s: %w", fileName, err)
+	}
+	return names, nil
+}
+
+// Load raygui icons (.rgi) from reader over global icons, returns the icons name ids
+func (ctx *Context) LoadIconsFromReader(r io.Reader) ([]string, error) {
+	names, err := ctx.loadIcons(r)
+	if err != nil {
+		return nil, fmt.Errorf("raygui: loading icons: %w", err)
+	}
+	return names, nil
+}
+
+func (ctx *Context) loadIcons(r io.Reader) ([]string, error) {
+	// Icons File Structure (.rgi)
+	// ------------------------------------------------------
+	// Offset  | Size    | Type       | Description
+	// ------------------------------------------------------
+	// 0       | 4       | char       | Signature: "rGI "
+	// 4       | 2       | short      | Version: 100
+	// 6       | 2       | short      | reserved
+
+	// 8       | 2       | short      | Num icons (N)
+	// 10      | 2       | short      | Icons size (Options: 16, 32, 64) (S)
+
+	// Icons name id (32 bytes per name id)
+	// foreach (icon)
+	// {
+	//   12+32*i  | 32   | char       | Icon NameId
+	// }
+
+	// Icons data: One bit per pixel, stored as unsigned int array (depends on icon size)
+	// S*S pixels/32bit per unsigned int = K unsigned int per icon
+	// foreach (icon)
+	// {
+	//   ...   | K       | unsigned int | Icon Data
+	// }
+
+	var header rgiHeader
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+	if string(header.Signature[:]) != rgiSignature {
+		return nil, fmt.Errorf("invalid signature %q", header.Signature[:])
+	}
+	if header.IconSize != RIconSize {
+		return nil, fmt.Errorf("icon size %d does not match %d", header.IconSize, RIconSize)
+	}
+	if header.IconCount < 0 || header.IconCount > RIconMaxIcons {
+		return nil, fmt.Errorf("invalid icon count %d", header.IconCount)
+	}
+
+	names := make([]string, header.IconCount)
+	nameBuffer := make([]byte, RIconMaxNameLength)
+	for i := range names {
+		if _, err := io.ReadFull(r, nameBuffer); err != nil {
+			return nil, err
+		}
+
+		// Name ids are NUL-padded to RIconMaxNameLength
+		name := nameBuffer
+		if end := bytes.IndexByte(name, 0); end >= 0 {
+			name = name[:end]
+		}
+		names[i] = string(name)
+	}
+
+	// Icons data is read completely before it is copied over context icons data array
+	data := make([]uint32, int(header.IconCount)*RIconDataElements)
+	if err := binary.Read(r, binary.LittleEndian, data); err != nil {
+		return nil, err
+	}
+	copy(ctx.icons[:], data)
+
+	return names, nil
+}
+
+func bitCheck(a, b uint32) uint32 {
+	return a & (1 << b)
+}
 
 // Draw selected icon using rectangles pixel-by-pixel
-func DrawIcon(iconId int, position rl.Vector2, pixelSize int, color rl.Color) {
+func (ctx *Context) DrawIcon(iconId int, position rl.Vector2, pixelSize int, color rl.Color) {
 	i := 0
 	y := 0
 	for ; i < RIconSize*RIconSize/32; i++ {
 		for k := 0; k < 32; k++ {
-			if bitCheck(guiIcons[iconId*RIconDataElements+i], uint32(k)) > 0 {
-				rl.DrawRectangle(int32(position.X+float32((k%RIconSize)*pixelSize)), int32(position.Y+float32(y*pixelSize)), int32(pixelSize), int32(pixelSize), color)
+			if bitCheck(ctx.icons[iconId*RIconDataElements+i], uint32(k)) > 0 {
+				ctx.backend.DrawRectangle(int32(position.X+float32((k%RIconSize)*pixelSize)), int32(position.Y+float32(y*pixelSize)), int32(pixelSize), int32(pixelSize), color)
 			}
 
 			if (k == 15) || (k == 31) {
@@ -1747,16 +4275,68 @@ func DrawIcon(iconId int, position rl.Vector2, pixelSize int, color rl.Color) {
 	}
 }
 
+// Get icon bit data
+// NOTE: Bit data array grouped as uint32 (RIconSize*RIconSize/32 elements)
+func (ctx *Context) GetIconData(iconId int) [RIconDataElements]uint32 {
+	var iconData [RIconDataElements]uint32
+
+	if iconId >= 0 && iconId < RIconMaxIcons {
+		copy(iconData[:], ctx.icons[iconId*RIconDataElements:])
+	}
+
+	return iconData
+}
+
+// Set icon bit data
+// NOTE: Data must be provided as uint32 array (RIconSize*RIconSize/32 elements)
+func (ctx *Context) SetIconData(iconId int, data [RIconDataElements]uint32) {
+	if iconId >= 0 && iconId < RIconMaxIcons {
+		copy(ctx.icons[iconId*RIconDataElements:], data[:])
+	}
+}
+
+// Get icon data element and bit for pixel
+// NOTE: This logic works for any RIconSize pixels icons,
+// for example, in case of 16x16 pixels, every 2 lines fit in one uint32 data element
+func iconPixelBit(iconId, x, y int) (int, uint32, bool) {
+	if iconId < 0 || iconId >= RIconMaxIcons || x < 0 || x >= RIconSize || y < 0 || y >= RIconSize {
+		return 0, 0, false
+	}
+
+	const linesPerElement = 32 / RIconSize
+	return iconId*RIconDataElements + y/linesPerElement, uint32(x + y%linesPerElement*RIconSize), true
+}
+
+// Set icon pixel value
+func (ctx *Context) SetIconPixel(iconId, x, y int) {
+	if i, bit, ok := iconPixelBit(iconId, x, y); ok {
+		ctx.icons[i] |= 1 << bit
+	}
+}
+
+// Clear icon pixel value
+func (ctx *Context) ClearIconPixel(iconId, x, y int) {
+	if i, bit, ok := iconPixelBit(iconId, x, y); ok {
+		ctx.icons[i] &^= 1 << bit
+	}
+}
+
+// Check icon pixel value
+func (ctx *Context) CheckIconPixel(iconId, x, y int) bool {
+	i, bit, ok := iconPixelBit(iconId, x, y)
+	return ok && bitCheck(ctx.icons[i], bit) > 0
+}
+
 //----------------------------------------------------------------------------------
 // Module specific Functions Definition
 //----------------------------------------------------------------------------------
 
 // Gui get text width using default font
-func GetTextWidth(text string) int {
+func (ctx *Context) GetTextWidth(text string) int {
 	var size rl.Vector2
 
 	if text != "" {
-		size = rl.MeasureTextEx(guiFont, text, float32(GetStyle(Default, TextSizeProp)), float32(GetStyle(Default, TextSpacingProp)))
+		size = ctx.backend.MeasureTextEx(ctx.font, text, float32(ctx.GetStyle(Default, TextSizeProp)), float32(ctx.GetStyle(Default, TextSpacingProp)))
 	}
 
 	// TODO: Consider text icon width here???
@@ -1764,25 +4344,33 @@ func GetTextWidth(text string) int {
 	return int(size.X)
 }
 
+// Gui get text width using default font, without rounding to whole pixels
+func (ctx *Context) measureText(text string) float32 {
+	if text == "" {
+		return 0
+	}
+	return ctx.backend.MeasureTextEx(ctx.font, text, float32(ctx.GetStyle(Default, TextSizeProp)), float32(ctx.GetStyle(Default, TextSpacingProp))).X
+}
+
 // Get text bounds considering control bounds
-func GetTextBounds(control Control, bounds rl.Rectangle) rl.Rectangle {
+func (ctx *Context) GetTextBounds(control Control, bounds rl.Rectangle) rl.Rectangle {
 	textBounds := bounds
 
-	textBounds.X = bounds.X + float32(GetStyle(control, BorderWidthProp))
-	textBounds.Y = bounds.Y + float32(GetStyle(control, BorderWidthProp))
-	textBounds.Width = bounds.Width - 2*float32(GetStyle(control, BorderWidthProp))
-	textBounds.Height = bounds.Height - 2*float32(GetStyle(control, BorderWidthProp))
+	textBounds.X = bounds.X + float32(ctx.GetStyle(control, BorderWidthProp))
+	textBounds.Y = bounds.Y + float32(ctx.GetStyle(control, BorderWidthProp))
+	textBounds.Width = bounds.Width - 2*float32(ctx.GetStyle(control, BorderWidthProp))
+	textBounds.Height = bounds.Height - 2*float32(ctx.GetStyle(control, BorderWidthProp))
 
 	// Consider TEXT_PADDING properly, depends on control type and TEXT_ALIGNMENT
 	switch control {
 	case ComboBoxControl:
-		bounds.Width -= float32(GetStyle(control, ComboButtonWidth)) + float32(GetStyle(control, ComboButtonPadding))
+		bounds.Width -= float32(ctx.GetStyle(control, ComboButtonWidth)) + float32(ctx.GetStyle(control, ComboButtonPadding))
 	case ValueBoxControl: // NOTE: ValueBox text value always centered, text padding applies to label
 	default:
-		if TextAlignment(GetStyle(control, TextAlignmentProp)) == TextAlignRight {
-			textBounds.X -= float32(GetStyle(control, TextPaddingProp))
+		if TextAlignment(ctx.GetStyle(control, TextAlignmentProp)) == TextAlignRight {
+			textBounds.X -= float32(ctx.GetStyle(control, TextPaddingProp))
 		} else {
-			textBounds.X += float32(GetStyle(control, TextPaddingProp))
+			textBounds.X += float32(ctx.GetStyle(control, TextPaddingProp))
 		}
 	}
 
@@ -1796,17 +4384,14 @@ func GetTextBounds(control Control, bounds rl.Rectangle) rl.Rectangle {
 // NOTE: We support up to 999 values for iconId
 func GetTextIcon(text string, iconId *int) string {
 	*iconId = -1
-	if text[0] == '#' { // Maybe we have an icon!
-		var iconValue [4]byte // Maximum length for icon value: 3 digits + '\0'
-
-		pos := 1
-		for (pos < 4) && (text[pos] >= '0') && (text[pos] <= '9') {
-			iconValue[pos-1] = text[pos]
+	if len(text) > 0 && text[0] == '#' { // Maybe we have an icon!
+		pos := 1 // Maximum length for icon value: 3 digits
+		for (pos < 4) && (pos < len(text)) && (text[pos] >= '0') && (text[pos] <= '9') {
 			pos++
 		}
 
-		if text[pos] == '#' {
-			*iconId = TextToInteger(string(iconValue[:]))
+		if (pos < len(text)) && (text[pos] == '#') {
+			*iconId = TextToInteger(text[1:pos])
 
 			// Move text pointer after icon
 			// WARNING: If only icon provided, it could point to EOL character!
@@ -1826,7 +4411,7 @@ func textValignPixelOffset(h float32) int {
 const RIconTextPadding = 4
 
 // Gui draw text using default font
-func DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl.Color) {
+func (ctx *Context) DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl.Color) {
 	if text != "" {
 		iconId := 0
 		text = GetTextIcon(text, &iconId) // Check text for icon and move cursor
@@ -1836,8 +4421,8 @@ func DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl
 		position := rl.Vector2{bounds.X, bounds.Y}
 
 		// NOTE: We get text size after icon been processed
-		textWidth := GetTextWidth(text)
-		textHeight := int(GetStyle(Default, TextSizeProp))
+		textWidth := ctx.GetTextWidth(text)
+		textHeight := int(ctx.GetStyle(Default, TextSizeProp))
 
 		// If text requires an icon, add size to measure
 		if iconId >= 0 {
@@ -1853,13 +4438,13 @@ func DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl
 		switch alignment {
 		case TextAlignLeft:
 			position.X = bounds.X
-			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight)/2 + float32(textValignPixelOffset(bounds.Height))
+			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight/2) + float32(textValignPixelOffset(bounds.Height))
 		case TextAlignCenter:
-			position.X = bounds.X + bounds.Width/2 - float32(textWidth)/2
-			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight)/2 + float32(textValignPixelOffset(bounds.Height))
+			position.X = bounds.X + bounds.Width/2 - float32(textWidth/2)
+			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight/2) + float32(textValignPixelOffset(bounds.Height))
 		case TextAlignRight:
 			position.X = bounds.X + bounds.Width - float32(textWidth)
-			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight)/2 + float32(textValignPixelOffset(bounds.Height))
+			position.Y = bounds.Y + bounds.Height/2 - float32(textHeight/2) + float32(textValignPixelOffset(bounds.Height))
 		}
 
 		// NOTE: Make sure we get pixel-perfect coordinates,
@@ -1872,27 +4457,27 @@ func DrawText(text string, bounds rl.Rectangle, alignment TextAlignment, tint rl
 		//---------------------------------------------------------------------------------
 		if iconId >= 0 {
 			// NOTE: We consider icon height, probably different than text size
-			DrawIcon(iconId, rl.Vector2{position.X, bounds.Y + bounds.Height/2 - RIconSize/2 + float32(textValignPixelOffset(bounds.Height))}, 1, tint)
+			ctx.DrawIcon(iconId, rl.Vector2{position.X, bounds.Y + bounds.Height/2 - RIconSize/2 + float32(textValignPixelOffset(bounds.Height))}, 1, tint)
 			position.X += RIconSize + RIconTextPadding
 		}
-		rl.DrawTextEx(guiFont, text, position, float32(GetStyle(Default, TextSizeProp)), float32(GetStyle(Default, TextSpacingProp)), tint)
+		ctx.backend.DrawTextEx(ctx.font, text, position, float32(ctx.GetStyle(Default, TextSizeProp)), float32(ctx.GetStyle(Default, TextSpacingProp)), tint)
 		//---------------------------------------------------------------------------------
 	}
 }
 
 // Gui draw rectangle using default raygui plain style with borders
-func DrawRectangle(rec rl.Rectangle, borderWidth int, borderColor, color rl.Color) {
+func (ctx *Context) DrawRectangle(rec rl.Rectangle, borderWidth int, borderColor, color rl.Color) {
 	if color.A > 0 {
 		// Draw rectangle filled with color
-		rl.DrawRectangle(int32(rec.X), int32(rec.Y), int32(rec.Width), int32(rec.Height), color)
+		ctx.backend.DrawRectangle(int32(rec.X), int32(rec.Y), int32(rec.Width), int32(rec.Height), color)
 	}
 
 	if borderWidth > 0 {
 		// Draw rectangle border lines with color
-		rl.DrawRectangle(int32(rec.X), int32(rec.Y), int32(rec.Width), int32(borderWidth), borderColor)
-		rl.DrawRectangle(int32(rec.X), int32(rec.Y)+int32(borderWidth), int32(borderWidth), int32(rec.Height)-2*int32(borderWidth), borderColor)
-		rl.DrawRectangle(int32(rec.X)+int32(rec.Width)-int32(borderWidth), int32(rec.Y)+int32(borderWidth), int32(borderWidth), int32(rec.Height)-2*int32(borderWidth), borderColor)
-		rl.DrawRectangle(int32(rec.X), int32(rec.Y)+int32(rec.Height)-int32(borderWidth), int32(rec.Width), int32(borderWidth), borderColor)
+		ctx.backend.DrawRectangle(int32(rec.X), int32(rec.Y), int32(rec.Width), int32(borderWidth), borderColor)
+		ctx.backend.DrawRectangle(int32(rec.X), int32(rec.Y)+int32(borderWidth), int32(borderWidth), int32(rec.Height)-2*int32(borderWidth), borderColor)
+		ctx.backend.DrawRectangle(int32(rec.X)+int32(rec.Width)-int32(borderWidth), int32(rec.Y)+int32(borderWidth), int32(borderWidth), int32(rec.Height)-2*int32(borderWidth), borderColor)
+		ctx.backend.DrawRectangle(int32(rec.X), int32(rec.Y)+int32(rec.Height)-int32(borderWidth), int32(rec.Width), int32(borderWidth), borderColor)
 	}
 
 	// TODO: For n-patch-based style we would need: [state] and maybe [control]
@@ -1902,19 +4487,16 @@ func DrawRectangle(rec rl.Rectangle, borderWidth int, borderColor, color rl.Colo
 const TextSplitMaxTextLength = 1024
 const TextSplitMaxTextElements = 128
 
-var tsResult [TextSplitMaxTextElements]string
-var tsBuffer [TextSplitMaxTextLength]byte
-
 // Split controls text into multiple strings
 // Also check for multiple columns (required by GuiToggleGroup())
 //
 // NOTE(port): This function's implementation is heavily modified from the original C, because
 // strings work very differently between C and Go. However, this implementation still uses a fixed
-// region of memory and shouldn't allocate.
-func TextSplit(text string, count *int, textRow []int) []string {
+// region of memory per context and shouldn't allocate.
+func (ctx *Context) TextSplit(text string, count *int, textRow []int) []string {
 	// NOTE(port): Go doesn't have memset and I don't want to do the weird hacks I'm finding online
-	for i := range tsBuffer {
-		tsBuffer[i] = 0
+	for i := range ctx.splitBuffer {
+		ctx.splitBuffer[i] = 0
 	}
 
 	// result[0] = buffer;
@@ -1927,13 +4509,13 @@ func TextSplit(text string, count *int, textRow []int) []string {
 	// Count how many substrings we have on text and point to every one
 	stringStart := 0
 	for i := 0; i < len(text) && i < TextSplitMaxTextLength; i++ {
-		tsBuffer[i] = text[i]
-		if tsBuffer[i] == ';' || tsBuffer[i] == '\n' {
-			tsResult[counter] = string(tsBuffer[stringStart:i])
+		ctx.splitBuffer[i] = text[i]
+		if ctx.splitBuffer[i] == ';' || ctx.splitBuffer[i] == '\n' {
+			ctx.splitResult[counter] = string(ctx.splitBuffer[stringStart:i])
 			stringStart = i + 1
 
 			if textRow != nil {
-				if tsBuffer[i] == '\n' {
+				if ctx.splitBuffer[i] == '\n' {
 					textRow[counter+1] = textRow[counter] + 1
 				} else {
 					textRow[counter+1] = textRow[counter]
@@ -1947,15 +4529,139 @@ func TextSplit(text string, count *int, textRow []int) []string {
 		}
 	}
 
-	remaining := tsBuffer[stringStart:]
-	if len(remaining) > 0 && counter < TextSplitMaxTextElements {
-		tsResult[counter] = string(remaining)
+	textLength := len(text)
+	if textLength > TextSplitMaxTextLength {
+		textLength = TextSplitMaxTextLength
+
+		// NOTE(port): Don't cut a multi-byte character in half at the maximum length
+		for textLength > stringStart && !utf8.RuneStart(text[textLength]) {
+			textLength--
+		}
+	}
+
+	// NOTE(port): Last element ends with the text, not with the NUL padding of the buffer,
+	// it's always added, even when empty, so there is at least one element like the original C
+	if counter < TextSplitMaxTextElements {
+		ctx.splitResult[counter] = string(ctx.splitBuffer[stringStart:textLength])
 		counter++
 	}
 
 	*count = counter
 
-	return tsResult[:counter]
+	return ctx.splitResult[:counter]
+}
+
+// Convert color data from RGB to HSV
+// NOTE: Color data should be passed normalized, hue is returned in degrees [0..360]
+func ConvertRGBtoHSV(rgb rl.Vector3) rl.Vector3 {
+	var hsv rl.Vector3
+
+	min := rgb.X
+	if rgb.Y < min {
+		min = rgb.Y
+	}
+	if rgb.Z < min {
+		min = rgb.Z
+	}
+
+	max := rgb.X
+	if rgb.Y > max {
+		max = rgb.Y
+	}
+	if rgb.Z > max {
+		max = rgb.Z
+	}
+
+	hsv.Z = max // Value
+	delta := max - min
+
+	if delta < 0.00001 {
+		hsv.Y = 0
+		hsv.X = 0 // Undefined, maybe NAN?
+		return hsv
+	}
+
+	if max > 0 {
+		// NOTE: If max is 0, this divide would cause a crash
+		hsv.Y = delta / max // Saturation
+	} else {
+		// NOTE: If max is 0, then r = g = b = 0, s = 0, h is undefined
+		hsv.Y = 0
+		hsv.X = 0 // Undefined, maybe NAN?
+		return hsv
+	}
+
+	// NOTE: Comparing float values could not work properly
+	if rgb.X >= max {
+		hsv.X = (rgb.Y - rgb.Z) / delta // Between yellow & magenta
+	} else if rgb.Y >= max {
+		hsv.X = 2 + (rgb.Z-rgb.X)/delta // Between cyan & yellow
+	} else {
+		hsv.X = 4 + (rgb.X-rgb.Y)/delta // Between magenta & cyan
+	}
+
+	hsv.X *= 60 // Convert to degrees
+
+	if hsv.X < 0 {
+		hsv.X += 360
+	}
+
+	return hsv
+}
+
+// Convert color data from HSV to RGB
+// NOTE: Color data should be passed normalized, hue in degrees [0..360]
+func ConvertHSVtoRGB(hsv rl.Vector3) rl.Vector3 {
+	var rgb rl.Vector3
+
+	// NOTE: Comparing float values could not work properly
+	if hsv.Y <= 0 {
+		rgb.X = hsv.Z
+		rgb.Y = hsv.Z
+		rgb.Z = hsv.Z
+		return rgb
+	}
+
+	hh := hsv.X
+	if hh >= 360 {
+		hh = 0
+	}
+	hh /= 60
+
+	i := int(hh)
+	ff := hh - float32(i)
+	p := hsv.Z * (1 - hsv.Y)
+	q := hsv.Z * (1 - (hsv.Y * ff))
+	t := hsv.Z * (1 - (hsv.Y * (1 - ff)))
+
+	switch i {
+	case 0:
+		rgb.X = hsv.Z
+		rgb.Y = t
+		rgb.Z = p
+	case 1:
+		rgb.X = q
+		rgb.Y = hsv.Z
+		rgb.Z = p
+	case 2:
+		rgb.X = p
+		rgb.Y = hsv.Z
+		rgb.Z = t
+	case 3:
+		rgb.X = p
+		rgb.Y = q
+		rgb.Z = hsv.Z
+	case 4:
+		rgb.X = t
+		rgb.Y = p
+		rgb.Z = hsv.Z
+	default:
+		rgb.X = hsv.Z
+		rgb.Y = p
+		rgb.Z = q
+	}
+
+	return rgb
 }
 
 // Get integer value from text
@@ -1998,3 +4704,7 @@ func CodepointToUTF8(codepoint int32, byteSize *int) string {
 func floor32(f float32) float32 {
 	return float32(math.Floor(float64(f)))
 }
+
+func round32(f float32) float32 {
+	return float32(math.Round(float64(f)))
+}
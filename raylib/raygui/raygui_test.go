@@ -0,0 +1,1458 @@
+package raygui
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Set headless backend on a new default context for a test
+func newTestBackend(t *testing.T) *HeadlessBackend {
+	t.Helper()
+
+	b := NewHeadlessBackend()
+	useTestContext(t, b)
+
+	return b
+}
+
+// Replace default context with a new one for a test, using backend and default style
+func useTestContext(t *testing.T, b Backend) {
+	t.Helper()
+
+	ctx := defaultContext
+	t.Cleanup(func() { defaultContext = ctx })
+
+	defaultContext = newTestContext(b)
+}
+
+// Create context using backend and default style
+func newTestContext(b Backend) *Context {
+	ctx := NewContext()
+	ctx.SetBackend(b)
+	ctx.LoadStyleDefault()
+
+	return ctx
+}
+
+// Start a new gui frame with input set by press, keys, gamepad buttons and
+// mouse button held in previous frame are released first, so they are pressed again
+func inputFrame(b *HeadlessBackend, press func()) {
+	b.NextFrame()
+	for key := range b.keys {
+		b.SetKey(key, false)
+	}
+	for button := range b.gamepadButtons {
+		b.gamepadButtons[button] = false
+	}
+	b.SetMouseButton(rl.MouseLeftButton, false)
+
+	b.NextFrame()
+	press()
+	BeginFrame()
+}
+
+// Start a new gui frame with keys pressed, see inputFrame()
+func pressKeys(b *HeadlessBackend, keys ...int32) {
+	inputFrame(b, func() {
+		for _, key := range keys {
+			b.SetKey(key, true)
+		}
+	})
+}
+
+// Start a new gui frame with mouse at position and left button state
+func mouseFrame(b *HeadlessBackend, position rl.Vector2, down bool) {
+	b.NextFrame()
+	b.MousePosition = position
+	b.SetMouseButton(rl.MouseLeftButton, down)
+	BeginFrame()
+}
+
+// Start a new frame with a key held down, keys pressed in previous frame are released
+func keyFrame(b *HeadlessBackend, key int32) {
+	b.NextFrame()
+	for _, k := range []int32{rl.KeyBackspace, rl.KeyEnter} {
+		b.SetKey(k, k == key)
+	}
+}
+
+// Press and release left mouse button at position, calling control every frame
+func click(b *HeadlessBackend, position rl.Vector2, control func()) {
+	mouseFrame(b, position, true)
+	control()
+	mouseFrame(b, position, false)
+	control()
+}
+
+func styleColor(control Control, property ControlProperty) rl.Color {
+	return rl.GetColor(int32(GetStyle(control, property)))
+}
+
+func containsText(texts []string, text string) bool {
+	for _, t := range texts {
+		if t == text {
+			return true
+		}
+	}
+	return false
+}
+
+func TestButton(t *testing.T) {
+	bounds := rl.Rectangle{X: 10, Y: 10, Width: 80, Height: 30}
+	inside := rl.Vector2{X: 50, Y: 25}
+
+	t.Run("pressed on release", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, inside, true)
+		if Button(bounds, "OK") {
+			t.Error("button pressed while mouse button is still down")
+		}
+		if got, want := b.DrawCallsOf(DrawRectangleCall)[0].Colors[0], styleColor(ButtonControl, BaseColorPressedProp); got != want {
+			t.Errorf("pressed base color = %v, want %v", got, want)
+		}
+
+		mouseFrame(b, inside, false)
+		if !Button(bounds, "OK") {
+			t.Error("button not pressed on release")
+		}
+		if !containsText(b.Texts(), "OK") {
+			t.Errorf("texts = %q, want button text", b.Texts())
+		}
+	})
+
+	t.Run("focused on hover", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, inside, false)
+		Button(bounds, "OK")
+
+		rects := b.DrawCallsOf(DrawRectangleCall)
+		if got, want := rects[0].Colors[0], styleColor(ButtonControl, BaseColorFocusedProp); got != want {
+			t.Errorf("base color = %v, want %v", got, want)
+		}
+		if got, want := rects[1].Colors[0], styleColor(ButtonControl, BorderColorFocusedProp); got != want {
+			t.Errorf("border color = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("released outside", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		var pressed bool
+		click(b, rl.Vector2{X: 200, Y: 200}, func() { pressed = Button(bounds, "OK") })
+		if pressed {
+			t.Error("button pressed by release outside bounds")
+		}
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		b := newTestBackend(t)
+		Disable()
+
+		var pressed bool
+		click(b, inside, func() { pressed = Button(bounds, "OK") })
+		if pressed {
+			t.Error("disabled button pressed")
+		}
+		if got, want := b.DrawCallsOf(DrawRectangleCall)[0].Colors[0], styleColor(ButtonControl, BaseColorDisabledProp); got != want {
+			t.Errorf("base color = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("locked", func(t *testing.T) {
+		b := newTestBackend(t)
+		Lock()
+
+		var pressed bool
+		click(b, inside, func() { pressed = Button(bounds, "OK") })
+		if pressed {
+			t.Error("button pressed while gui is locked")
+		}
+	})
+}
+
+func TestToggle(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 60, Height: 20}
+	inside := rl.Vector2{X: 30, Y: 10}
+
+	b := newTestBackend(t)
+
+	active := false
+	for i, want := range []bool{true, false, true} {
+		click(b, inside, func() { active = Toggle(bounds, "T", active) })
+		if active != want {
+			t.Fatalf("click %d: active = %v, want %v", i, active, want)
+		}
+	}
+
+	// Active toggle is drawn with pressed colors when not hovered
+	mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+	Toggle(bounds, "T", true)
+	if got, want := b.DrawCallsOf(DrawRectangleCall)[0].Colors[0], styleColor(ToggleControl, BaseColorPressedProp); got != want {
+		t.Errorf("active base color = %v, want %v", got, want)
+	}
+
+	mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+	if !Toggle(bounds, "T", true) {
+		t.Error("toggle changed without input")
+	}
+}
+
+func TestToggleGroup(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 40, Height: 20}
+	padding := float32(GetStyle(ToggleControl, GroupPadding))
+
+	tests := []struct {
+		name   string
+		text   string
+		click  rl.Vector2
+		active int
+		want   int
+	}{
+		{"second item", "A;B;C", rl.Vector2{X: 40 + padding + 10, Y: 10}, 0, 1},
+		{"third item", "A;B;C", rl.Vector2{X: 2*(40+padding) + 10, Y: 10}, 0, 2},
+		{"active item again", "A;B;C", rl.Vector2{X: 10, Y: 10}, 0, 0},
+		{"padding between items", "A;B;C", rl.Vector2{X: 40 + padding/2, Y: 10}, 2, 2},
+		{"second row", "A;B\nC", rl.Vector2{X: 10, Y: 20 + padding + 10}, 0, 2},
+		{"outside", "A;B;C", rl.Vector2{X: 300, Y: 10}, 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			var got int
+			click(b, tt.click, func() { got = ToggleGroup(bounds, tt.text, tt.active) })
+			if got != tt.want {
+				t.Errorf("active = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 15, Height: 15}
+
+	tests := []struct {
+		name  string
+		click rl.Vector2
+		want  bool
+	}{
+		{"box", rl.Vector2{X: 7, Y: 7}, true},
+		{"label", rl.Vector2{X: 30, Y: 7}, true},
+		{"outside", rl.Vector2{X: 200, Y: 7}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			var got bool
+			click(b, tt.click, func() { got = CheckBox(bounds, "Check", false) })
+			if got != tt.want {
+				t.Errorf("checked = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("check mark", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+		CheckBox(bounds, "Check", false)
+		unchecked := len(b.DrawCallsOf(DrawRectangleCall))
+
+		mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+		CheckBox(bounds, "Check", true)
+		if got := len(b.DrawCallsOf(DrawRectangleCall)); got != unchecked+1 {
+			t.Errorf("checked box drew %d rectangles, want %d", got, unchecked+1)
+		}
+	})
+}
+
+func TestComboBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 120, Height: 20}
+	selector := rl.Vector2{X: 110, Y: 10}
+
+	t.Run("cycles on press", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 0
+		for i, want := range []int{1, 2, 0} {
+			mouseFrame(b, rl.Vector2{X: 20, Y: 10}, true)
+			active = ComboBox(bounds, "A;B;C", active)
+			if active != want {
+				t.Fatalf("press %d: active = %d, want %d", i, active, want)
+			}
+
+			mouseFrame(b, rl.Vector2{X: 20, Y: 10}, false)
+			if got := ComboBox(bounds, "A;B;C", active); got != active {
+				t.Fatalf("release %d: active = %d, want %d", i, got, active)
+			}
+		}
+	})
+
+	t.Run("selector button", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, selector, true)
+		active := ComboBox(bounds, "A;B;C", 0)
+		if active != 1 {
+			t.Errorf("active = %d, want 1", active)
+		}
+		if texts := b.Texts(); !containsText(texts, "B") || !containsText(texts, "2/3") {
+			t.Errorf("texts = %q, want selected item and counter", texts)
+		}
+	})
+
+	t.Run("clamps active", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+		if got := ComboBox(bounds, "A;B;C", 7); got != 2 {
+			t.Errorf("active = %d, want 2", got)
+		}
+	})
+
+	t.Run("single item", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, selector, true)
+		if got := ComboBox(bounds, "A", 0); got != 0 {
+			t.Errorf("active = %d, want 0", got)
+		}
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, selector, true)
+		if got := ComboBox(bounds, "", 0); got != 0 {
+			t.Errorf("active = %d, want 0", got)
+		}
+	})
+}
+
+func TestDropdownBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+	itemHeight := bounds.Height + float32(GetStyle(DropdownBoxControl, DropdownItemsPadding))
+
+	t.Run("opens on press", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 0
+		mouseFrame(b, rl.Vector2{X: 50, Y: 10}, true)
+		if !DropdownBox(bounds, "A;B;C", &active, false) {
+			t.Error("dropdown not pressed")
+		}
+	})
+
+	t.Run("selects item", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 0
+		item := rl.Vector2{X: 50, Y: 2*itemHeight + 5}
+		var pressed bool
+		click(b, item, func() { pressed = DropdownBox(bounds, "A;B;C", &active, true) })
+		if !pressed {
+			t.Error("dropdown not closed after selecting item")
+		}
+		if active != 1 {
+			t.Errorf("active = %d, want 1", active)
+		}
+	})
+
+	t.Run("draws items when open", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 2
+		mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+		DropdownBox(bounds, "A;B;C", &active, true)
+
+		texts := b.Texts()
+		for _, item := range []string{"A", "B", "C"} {
+			if !containsText(texts, item) {
+				t.Errorf("texts = %q, missing %q", texts, item)
+			}
+		}
+	})
+
+	t.Run("closes outside", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 2
+		mouseFrame(b, rl.Vector2{X: 300, Y: 300}, true)
+		if !DropdownBox(bounds, "A;B;C", &active, true) {
+			t.Error("dropdown not closed by press outside")
+		}
+		if active != 2 {
+			t.Errorf("active = %d, want 2", active)
+		}
+	})
+
+	t.Run("closed ignores items", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		active := 0
+		var pressed bool
+		click(b, rl.Vector2{X: 50, Y: 2*itemHeight + 5}, func() { pressed = DropdownBox(bounds, "A;B;C", &active, false) })
+		if pressed || active != 0 {
+			t.Errorf("pressed = %v, active = %d, want false, 0", pressed, active)
+		}
+	})
+
+	t.Run("clamps active", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		for _, editMode := range []bool{true, false} {
+			active := 3
+			mouseFrame(b, rl.Vector2{X: 200, Y: 200}, false)
+			DropdownBox(bounds, "A", &active, editMode)
+			if active != 0 {
+				t.Errorf("active = %d, want 0 with one item", active)
+			}
+			if texts := b.Texts(); !containsText(texts, "A") {
+				t.Errorf("texts = %q, want selected item", texts)
+			}
+		}
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Single empty item, drawn open and closed
+		active := 0
+		for _, editMode := range []bool{true, false} {
+			click(b, rl.Vector2{X: 50, Y: itemHeight + 5}, func() { DropdownBox(bounds, "", &active, editMode) })
+			if active != 0 {
+				t.Errorf("active = %d, want 0", active)
+			}
+		}
+	})
+}
+
+func TestTextBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 30}
+
+	t.Run("typing", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text := ""
+		for _, r := range "hi!" {
+			b.NextFrame()
+			b.TypeText(string(r))
+			text, _ = TextBox(bounds, text, 64, true)
+		}
+		if text != "hi!" {
+			t.Errorf("text = %q, want %q", text, "hi!")
+		}
+	})
+
+	t.Run("backspace", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		keyFrame(b, rl.KeyBackspace)
+		text, _ := TextBox(bounds, "abc", 64, true)
+		if text != "ab" {
+			t.Errorf("text = %q, want %q", text, "ab")
+		}
+
+		// Held key only deletes once
+		keyFrame(b, rl.KeyBackspace)
+		text, _ = TextBox(bounds, text, 64, true)
+		if text != "ab" {
+			t.Errorf("text = %q after holding backspace, want %q", text, "ab")
+		}
+	})
+
+	t.Run("enter", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		keyFrame(b, rl.KeyEnter)
+		if _, pressed := TextBox(bounds, "abc", 64, true); !pressed {
+			t.Error("enter did not end edit mode")
+		}
+	})
+
+	t.Run("size limit", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		b.NextFrame()
+		b.TypeText("c")
+		if text, _ := TextBox(bounds, "ab", 3, true); text != "ab" {
+			t.Errorf("text = %q, want %q", text, "ab")
+		}
+	})
+
+	t.Run("not editing", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		b.NextFrame()
+		b.TypeText("x")
+		if text, pressed := TextBox(bounds, "ab", 64, false); text != "ab" || pressed {
+			t.Errorf("text, pressed = %q, %v, want %q, false", text, pressed, "ab")
+		}
+	})
+
+	t.Run("click to edit", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 50, Y: 15}, true)
+		if _, pressed := TextBox(bounds, "ab", 64, false); !pressed {
+			t.Error("click inside did not start edit mode")
+		}
+
+		mouseFrame(b, rl.Vector2{X: 500, Y: 15}, false)
+		TextBox(bounds, "ab", 64, true)
+		mouseFrame(b, rl.Vector2{X: 500, Y: 15}, true)
+		if _, pressed := TextBox(bounds, "ab", 64, true); !pressed {
+			t.Error("click outside did not end edit mode")
+		}
+	})
+}
+
+func TestValueBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	t.Run("typing", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 0
+		for _, r := range "42-" {
+			b.NextFrame()
+			b.TypeText(string(r))
+			ValueBox(bounds, "", &value, -100, 100, true)
+		}
+		if value != -42 {
+			t.Errorf("value = %d, want -42", value)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Digits that would overflow the value are dropped
+		value := math.MaxInt64 / 10
+		b.NextFrame()
+		b.TypeText("9")
+		ValueBox(bounds, "", &value, 0, 100, true)
+		if value != math.MaxInt64/10 {
+			t.Errorf("value = %d, want %d", value, math.MaxInt64/10)
+		}
+
+		b.NextFrame()
+		b.TypeText("7")
+		ValueBox(bounds, "", &value, 0, 100, true)
+		if value != math.MaxInt64 {
+			t.Errorf("value = %d, want %d", value, math.MaxInt64)
+		}
+
+		// Value is clamped once editing ends
+		b.NextFrame()
+		ValueBox(bounds, "", &value, 0, 100, false)
+		if value != 100 {
+			t.Errorf("value = %d, want 100", value)
+		}
+	})
+}
+
+func TestSpinner(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 120, Height: 30}
+	rightArrow := rl.Vector2{X: 110, Y: 15}
+
+	t.Run("click", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 5
+		click(b, rightArrow, func() { Spinner(bounds, "", &value, 0, 10, false) })
+		if value != 6 {
+			t.Errorf("value = %d, want 6", value)
+		}
+
+		click(b, rl.Vector2{X: 10, Y: 15}, func() { Spinner(bounds, "", &value, 0, 10, false) })
+		if value != 5 {
+			t.Errorf("value = %d, want 5", value)
+		}
+	})
+
+	t.Run("hold to repeat", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 0
+		hold := func(seconds float32) {
+			for i := 0; i < int(seconds/b.FrameTime); i++ {
+				mouseFrame(b, rightArrow, true)
+				Spinner(bounds, "", &value, 0, 100, false)
+			}
+		}
+
+		// Nothing happens until the repeat delay is reached
+		hold(SpinnerRepeatDelay * 0.8)
+		if value != 0 {
+			t.Fatalf("value = %d before repeat delay, want 0", value)
+		}
+
+		// Steps repeat every interval while held, about one second in total
+		hold(1 - SpinnerRepeatDelay*0.8)
+		want := int((1-SpinnerRepeatDelay)/SpinnerRepeatInterval) + 1
+		if value < want-1 || value > want {
+			t.Fatalf("value = %d after holding one second, want %d", value, want)
+		}
+
+		// Releasing after repeating doesn't step again
+		held := value
+		mouseFrame(b, rightArrow, false)
+		Spinner(bounds, "", &value, 0, 100, false)
+		if value != held {
+			t.Errorf("value = %d after release, want %d", value, held)
+		}
+	})
+
+	t.Run("hold clamps", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 8
+		for i := 0; i < 120; i++ {
+			mouseFrame(b, rightArrow, true)
+			Spinner(bounds, "", &value, 0, 10, false)
+		}
+		if value != 10 {
+			t.Errorf("value = %d, want 10", value)
+		}
+	})
+}
+
+// Get rectangles filled with color in current frame
+func filledRects(b *HeadlessBackend, color rl.Color) []rl.Rectangle {
+	var rects []rl.Rectangle
+	for _, call := range b.DrawCallsOf(DrawRectangleCall) {
+		if call.Colors[0] == color {
+			rects = append(rects, call.Rec)
+		}
+	}
+	return rects
+}
+
+// Get rectangles filled with progress bar progress color in current frame
+func progressRects(b *HeadlessBackend) []rl.Rectangle {
+	return filledRects(b, styleColor(ProgressBarControl, BaseColorPressedProp))
+}
+
+func TestProgressBar(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 102, Height: 20}
+	border := float32(GetStyle(ProgressBarControl, BorderWidthProp))
+	inner := bounds.Width - 2*border
+
+	for _, tt := range []struct {
+		name                      string
+		value, minValue, maxValue float32
+		want                      float32
+	}{
+		{"empty", 0, 0, 100, 0},
+		{"half", 50, 0, 100, inner / 2},
+		{"full", 100, 0, 100, inner},
+		{"over max", 150, 0, 100, inner},
+		{"under min", -10, 0, 100, 0},
+		{"min offset", 75, 50, 100, inner / 2},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			b.NextFrame()
+			if got := ProgressBar(bounds, "", "", tt.value, tt.minValue, tt.maxValue); got != tt.value {
+				t.Errorf("value = %v, want %v unchanged", got, tt.value)
+			}
+
+			rects := progressRects(b)
+			if len(rects) != 1 {
+				t.Fatalf("%d progress rectangles drawn, want 1", len(rects))
+			}
+			if got := rects[0].Width; math.Abs(float64(got-tt.want)) > 1 {
+				t.Errorf("progress width = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("indeterminate", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Segment position only depends on the frames drawn
+		b.Time = 1000
+		segment := inner * ProgressBarMarqueeWidth
+		frames := int(math.Round(ProgressBarMarqueePeriod / float64(b.FrameTime)))
+
+		var rects []rl.Rectangle
+		for i := 0; i < frames; i++ {
+			b.NextFrame()
+			BeginFrame()
+			ProgressBarIndeterminate(bounds, "", "")
+			rects = append(rects, progressRects(b)...)
+		}
+		if len(rects) != frames {
+			t.Fatalf("%d progress rectangles drawn, want %d", len(rects), frames)
+		}
+
+		// Segment enters from the left edge, crosses the bar and leaves through the right edge
+		half := rects[frames/2]
+		wantX := border - segment + (inner+segment)/2
+		if math.Abs(float64(half.X-wantX)) > 1 || math.Abs(float64(half.Width-segment)) > 1 {
+			t.Errorf("segment = %v, %v half way, want %v, %v", half.X, half.Width, wantX, segment)
+		}
+		for i := 1; i < frames; i++ {
+			if rects[i].X+rects[i].Width < rects[i-1].X+rects[i-1].Width {
+				t.Fatalf("segment end moved back from %v to %v at frame %d", rects[i-1].X+rects[i-1].Width, rects[i].X+rects[i].Width, i)
+			}
+		}
+
+		// Next period starts again from the left edge
+		b.NextFrame()
+		BeginFrame()
+		ProgressBarIndeterminate(bounds, "", "")
+		if got := progressRects(b); len(got) != 1 || got[0].X != border || got[0].Width > 2 {
+			t.Errorf("segment = %v after a period, want starting at left edge", got)
+		}
+	})
+
+	t.Run("indeterminate two bars", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Segments move at the speed of a single bar, in step
+		segment := inner * ProgressBarMarqueeWidth
+		frames := int(math.Round(ProgressBarMarqueePeriod / float64(b.FrameTime)))
+		below := rl.Rectangle{X: bounds.X, Y: bounds.Y + 50, Width: bounds.Width, Height: bounds.Height}
+
+		for i := 0; i <= frames/2; i++ {
+			b.NextFrame()
+			BeginFrame()
+			ProgressBarIndeterminate(bounds, "", "")
+			ProgressBarIndeterminate(below, "", "")
+		}
+
+		rects := progressRects(b)
+		wantX := border - segment + (inner+segment)/2
+		if len(rects) != 2 || math.Abs(float64(rects[0].X-wantX)) > 1 || rects[1].X != rects[0].X {
+			t.Errorf("segments = %v half way, want both at %v", rects, wantX)
+		}
+	})
+}
+
+func TestSlider(t *testing.T) {
+	sliderWidth := float32(GetStyle(SliderControl, SliderWidth))
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100 + sliderWidth, Height: 20}
+	at := func(value float32) rl.Vector2 { return rl.Vector2{X: float32(int(sliderWidth)/2) + value, Y: 10} }
+
+	t.Run("drag", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := float32(0)
+		for _, step := range []struct {
+			mouse rl.Vector2
+			down  bool
+			want  float32
+		}{
+			{at(50), true, 50},
+			{at(75), true, 75},
+			{at(20), false, 75},                  // Released
+			{rl.Vector2{X: 50, Y: 50}, true, 75}, // Held outside bounds
+		} {
+			mouseFrame(b, step.mouse, step.down)
+			if value = Slider(bounds, "", "", value, 0, 100); value != step.want {
+				t.Fatalf("value = %v with mouse at %v, want %v", value, step.mouse, step.want)
+			}
+		}
+	})
+
+	t.Run("clamps value", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 1, Y: 10}, true)
+		if got := Slider(bounds, "", "", 50, 0, 100); got != 0 {
+			t.Errorf("value = %v dragging left of range, want 0", got)
+		}
+		mouseFrame(b, rl.Vector2{X: bounds.Width - 1, Y: 10}, true)
+		if got := Slider(bounds, "", "", 50, 0, 100); got != 100 {
+			t.Errorf("value = %v dragging right of range, want 100", got)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 500, Y: 500}, false)
+		if got := Slider(bounds, "", "", 150, 0, 100); got != 100 {
+			t.Errorf("value = %v, want 100", got)
+		}
+		if got := Slider(bounds, "", "", -5, 0, 100); got != 0 {
+			t.Errorf("value = %v, want 0", got)
+		}
+	})
+
+	t.Run("slider position", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 500, Y: 500}, false)
+		Slider(bounds, "", "", 50, 0, 100)
+		rects := filledRects(b, styleColor(SliderControl, BaseColorPressedProp))
+		if len(rects) != 1 {
+			t.Fatalf("%d slider rectangles drawn, want 1", len(rects))
+		}
+		if rects[0].Width != sliderWidth || math.Abs(float64(rects[0].X+rects[0].Width/2-bounds.Width/2)) > 1 {
+			t.Errorf("slider = %v, want %v wide centered in bounds", rects[0], sliderWidth)
+		}
+	})
+}
+
+func TestSliderBar(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 102, Height: 20}
+	border := float32(GetStyle(SliderControl, BorderWidthProp))
+
+	t.Run("fill", func(t *testing.T) {
+		for _, tt := range []struct {
+			value float32
+			want  float32
+		}{{0, 0}, {25, 25}, {100, 100}, {150, 100}} {
+			b := newTestBackend(t)
+
+			mouseFrame(b, rl.Vector2{X: 500, Y: 500}, false)
+			SliderBar(bounds, "", "", tt.value, 0, 100)
+			rects := filledRects(b, styleColor(SliderControl, BaseColorPressedProp))
+			if len(rects) != 1 {
+				t.Fatalf("%d bar rectangles drawn, want 1", len(rects))
+			}
+			if rects[0].X != bounds.X+border || math.Abs(float64(rects[0].Width-tt.want)) > 1 {
+				t.Errorf("bar = %v for value %v, want %v wide from left border", rects[0], tt.value, tt.want)
+			}
+		}
+	})
+
+	// Value follows mouse across the whole bounds width
+	t.Run("drag", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 51, Y: 10}, true)
+		value := SliderBar(bounds, "", "", 0, 0, 100)
+		if math.Abs(float64(value-50)) > 0.01 {
+			t.Errorf("value = %v, want 50", value)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 76.5, Y: 10}, true)
+		if value = SliderBar(bounds, "", "", value, 0, 100); math.Abs(float64(value-75)) > 0.01 {
+			t.Errorf("value = %v, want 75", value)
+		}
+	})
+}
+
+func TestListView(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 150, Height: 100}
+	items := []string{"0", "1", "2", "3", "4", "5", "6", "7", "8", "9"}
+
+	// Get position over visible row of the list
+	row := func(i int) rl.Vector2 {
+		stride := float32(GetStyle(ListViewControl, ListItemsHeight) + GetStyle(ListViewControl, ListItemsPadding))
+		top := float32(GetStyle(ListViewControl, ListItemsPadding) + GetStyle(Default, BorderWidthProp))
+		return rl.Vector2{X: 50, Y: top + stride*float32(i) + stride/2}
+	}
+
+	t.Run("select and focus", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		focus, scrollIndex, active := -1, 0, -1
+		mouseFrame(b, row(1), false)
+		if active = ListViewEx(bounds, items, &focus, &scrollIndex, active); active != -1 || focus != 1 {
+			t.Fatalf("active, focus = %d, %d hovering row 1, want -1, 1", active, focus)
+		}
+
+		mouseFrame(b, row(1), true)
+		if active = ListViewEx(bounds, items, &focus, &scrollIndex, active); active != 1 || focus != 1 {
+			t.Fatalf("active, focus = %d, %d clicking row 1, want 1, 1", active, focus)
+		}
+
+		// Clicking the active item again deselects it
+		mouseFrame(b, row(1), false)
+		ListViewEx(bounds, items, &focus, &scrollIndex, active)
+		mouseFrame(b, row(1), true)
+		if active = ListViewEx(bounds, items, &focus, &scrollIndex, active); active != -1 {
+			t.Errorf("active = %d clicking active row, want -1", active)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 500, Y: 500}, false)
+		if ListViewEx(bounds, items, &focus, &scrollIndex, active); focus != -1 {
+			t.Errorf("focus = %d with mouse outside, want -1", focus)
+		}
+	})
+
+	t.Run("scroll index", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		focus, scrollIndex, active := -1, 0, -1
+		mouseFrame(b, row(0), false)
+		b.MouseWheelMove = -2
+		if ListViewEx(bounds, items, &focus, &scrollIndex, active); scrollIndex != 2 {
+			t.Fatalf("scrollIndex = %d after scrolling, want 2", scrollIndex)
+		}
+
+		// Rows focus items from the scroll index
+		mouseFrame(b, row(0), false)
+		if ListViewEx(bounds, items, &focus, &scrollIndex, active); focus != 2 {
+			t.Errorf("focus = %d hovering row 0, want 2", focus)
+		}
+
+		mouseFrame(b, row(1), true)
+		if active = ListViewEx(bounds, items, &focus, &scrollIndex, active); active != 3 {
+			t.Errorf("active = %d clicking row 1, want 3", active)
+		}
+
+		// Scroll index is clamped to the last page
+		mouseFrame(b, row(0), false)
+		b.MouseWheelMove = -100
+		visible := int(bounds.Height) / int(GetStyle(ListViewControl, ListItemsHeight)+GetStyle(ListViewControl, ListItemsPadding))
+		if ListViewEx(bounds, items, &focus, &scrollIndex, active); scrollIndex != len(items)-visible {
+			t.Errorf("scrollIndex = %d, want %d", scrollIndex, len(items)-visible)
+		}
+
+		// Invalid scroll index starts from the first item
+		scrollIndex = 50
+		mouseFrame(b, rl.Vector2{X: 500, Y: 500}, false)
+		if ListViewEx(bounds, items, &focus, &scrollIndex, active); scrollIndex != 0 {
+			t.Errorf("scrollIndex = %d, want 0", scrollIndex)
+		}
+	})
+
+	t.Run("text items", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		scrollIndex := 0
+		mouseFrame(b, row(2), true)
+		if active := ListView(bounds, "A;B;C", &scrollIndex, 0); active != 2 {
+			t.Errorf("active = %d, want 2", active)
+		}
+		if texts := b.Texts(); !containsText(texts, "C") {
+			t.Errorf("texts = %q, want items drawn", texts)
+		}
+	})
+}
+
+func TestScrollBar(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 10, Height: 100}
+
+	t.Run("mouse wheel", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 5, Y: 50}, false)
+		b.MouseWheelMove = 3
+		if got := ScrollBar(bounds, 50, 0, 100); got != 53 {
+			t.Errorf("value = %d, want 53", got)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 50, Y: 50}, false)
+		b.MouseWheelMove = 3
+		if got := ScrollBar(bounds, 50, 0, 100); got != 50 {
+			t.Errorf("value = %d scrolling outside, want 50", got)
+		}
+	})
+
+	t.Run("clamps value", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 5, Y: 50}, false)
+		b.MouseWheelMove = -10
+		if got := ScrollBar(bounds, 5, 0, 100); got != 0 {
+			t.Errorf("value = %d, want 0", got)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 50, Y: 50}, false)
+		if got := ScrollBar(bounds, 150, 0, 100); got != 100 {
+			t.Errorf("value = %d, want 100", got)
+		}
+	})
+
+	t.Run("drag", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 5, Y: 50}, true)
+		value := ScrollBar(bounds, 0, 0, 100)
+
+		// Slider is centered on the mouse while dragging
+		mouseFrame(b, rl.Vector2{X: 5, Y: 50}, true)
+		if value = ScrollBar(bounds, value, 0, 100); value != 50 {
+			t.Errorf("value = %d, want 50", value)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 5, Y: 100}, true)
+		if value = ScrollBar(bounds, value, 0, 100); value != 100 {
+			t.Errorf("value = %d, want 100", value)
+		}
+	})
+
+	t.Run("horizontal", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		horizontal := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 10}
+		mouseFrame(b, rl.Vector2{X: 50, Y: 5}, true)
+		ScrollBar(horizontal, 0, 0, 100)
+		mouseFrame(b, rl.Vector2{X: 50, Y: 5}, true)
+		if got := ScrollBar(horizontal, 0, 0, 100); got != 50 {
+			t.Errorf("value = %d, want 50", got)
+		}
+	})
+}
+
+func TestScrollPanel(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 100}
+	inside := rl.Vector2{X: 50, Y: 50}
+
+	t.Run("mouse wheel", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		content := rl.Rectangle{Width: 50, Height: 300}
+		scroll := rl.Vector2{}
+
+		mouseFrame(b, inside, false)
+		b.MouseWheelMove = -1
+		ScrollPanel(bounds, content, &scroll)
+		if scroll.Y != -20 {
+			t.Errorf("scroll.Y = %v, want -20", scroll.Y)
+		}
+
+		// Scrolling stops at the end of the content
+		mouseFrame(b, inside, false)
+		b.MouseWheelMove = -100
+		ScrollPanel(bounds, content, &scroll)
+		bw := float32(GetStyle(Default, BorderWidthProp))
+		if want := -(content.Height - bounds.Height + bw); scroll.Y != want {
+			t.Errorf("scroll.Y = %v, want %v", scroll.Y, want)
+		}
+
+		// Wheel outside the panel is ignored
+		mouseFrame(b, rl.Vector2{X: 300, Y: 300}, false)
+		b.MouseWheelMove = 5
+		before := scroll
+		ScrollPanel(bounds, content, &scroll)
+		if scroll != before {
+			t.Errorf("scroll = %v, want %v", scroll, before)
+		}
+	})
+
+	t.Run("view", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, inside, false)
+		bw := float32(GetStyle(Default, BorderWidthProp))
+		scrollBarWidth := float32(GetStyle(ListViewControl, ScrollBarWidth))
+
+		view := ScrollPanel(bounds, rl.Rectangle{Width: 300, Height: 300}, nil)
+		want := rl.Rectangle{X: bw, Y: bw, Width: bounds.Width - 2*bw - scrollBarWidth, Height: bounds.Height - 2*bw - scrollBarWidth}
+		if view != want {
+			t.Errorf("view = %v, want %v", view, want)
+		}
+
+		// View is clipped to content smaller than the panel
+		view = ScrollPanel(bounds, rl.Rectangle{Width: 40, Height: 30}, nil)
+		if view.Width != 40 || view.Height != 30 {
+			t.Errorf("view = %v, want 40x30", view)
+		}
+	})
+
+	t.Run("content fits", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		content := rl.Rectangle{Width: 50, Height: 50}
+		scroll := rl.Vector2{}
+
+		mouseFrame(b, inside, false)
+		ScrollPanel(bounds, content, &scroll)
+		before := scroll
+
+		mouseFrame(b, inside, false)
+		b.MouseWheelMove = -3
+		ScrollPanel(bounds, content, &scroll)
+		if scroll != before {
+			t.Errorf("scroll = %v, want %v", scroll, before)
+		}
+	})
+}
+
+func TestGrid(t *testing.T) {
+	bounds := rl.Rectangle{X: 10, Y: 20, Width: 100, Height: 70}
+
+	for _, tt := range []struct {
+		name    string
+		spacing float32
+		mouse   rl.Vector2
+		want    rl.Vector2
+		lines   int
+	}{
+		{"first cell", 40, rl.Vector2{X: 15, Y: 25}, rl.Vector2{X: 0, Y: 0}, 3 + 2},
+		{"last cell", 40, rl.Vector2{X: 105, Y: 85}, rl.Vector2{X: 2, Y: 1}, 3 + 2},
+		{"outside", 40, rl.Vector2{X: 5, Y: 25}, rl.Vector2{X: -1, Y: -1}, 3 + 2},
+		{"zero spacing", 0, rl.Vector2{X: 15, Y: 25}, rl.Vector2{X: -1, Y: -1}, 0},
+		{"negative spacing", -40, rl.Vector2{X: 15, Y: 25}, rl.Vector2{X: -1, Y: -1}, 0},
+	} {
+		b := newTestBackend(t)
+
+		mouseFrame(b, tt.mouse, false)
+		if got := Grid(bounds, tt.spacing, 1); got != tt.want {
+			t.Errorf("%s: Grid() = %v, want %v", tt.name, got, tt.want)
+		}
+		if lines := len(b.DrawCallsOf(DrawRectangleCall)); lines != tt.lines {
+			t.Errorf("%s: drew %d lines, want %d", tt.name, lines, tt.lines)
+		}
+	}
+}
+
+func TestGridSnap(t *testing.T) {
+	bounds := rl.Rectangle{X: 10, Y: 20, Width: 100, Height: 70}
+
+	for _, tt := range []struct {
+		name    string
+		spacing float32
+		subdivs int
+		point   rl.Vector2
+		want    rl.Vector2
+	}{
+		{"major line", 40, 1, rl.Vector2{X: 48, Y: 25}, rl.Vector2{X: 50, Y: 20}},
+		{"minor line", 40, 2, rl.Vector2{X: 38, Y: 41}, rl.Vector2{X: 30, Y: 40}},
+		{"no subdivisions", 40, 0, rl.Vector2{X: 38, Y: 35}, rl.Vector2{X: 50, Y: 20}},
+		{"negative subdivisions", 40, -2, rl.Vector2{X: 38, Y: 35}, rl.Vector2{X: 50, Y: 20}},
+		{"before bounds", 40, 2, rl.Vector2{X: -30, Y: 0}, rl.Vector2{X: 10, Y: 20}},
+		{"after last line", 40, 2, rl.Vector2{X: 105, Y: 85}, rl.Vector2{X: 90, Y: 60}},
+		{"after bounds", 40, 2, rl.Vector2{X: 500, Y: 500}, rl.Vector2{X: 90, Y: 60}},
+		{"bounds multiple of spacing", 20, 1, rl.Vector2{X: 108, Y: 89}, rl.Vector2{X: 110, Y: 80}},
+		{"zero spacing", 0, 2, rl.Vector2{X: 33, Y: 44}, rl.Vector2{X: 33, Y: 44}},
+		{"negative spacing", -40, 2, rl.Vector2{X: 33, Y: 44}, rl.Vector2{X: 33, Y: 44}},
+	} {
+		if got := GridSnap(bounds, tt.spacing, tt.subdivs, tt.point); got != tt.want {
+			t.Errorf("%s: GridSnap(%v) = %v, want %v", tt.name, tt.point, got, tt.want)
+		}
+	}
+}
+
+func TestColorPicker(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 100}
+	red := rl.Color{R: 255, G: 0, B: 0, A: 200}
+
+	t.Run("panel", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		mouseFrame(b, rl.Vector2{X: 50, Y: 50}, false)
+		if got := ColorPanel(bounds, red); got != red {
+			t.Errorf("color = %v hovering, want %v", got, red)
+		}
+
+		// Saturation along x, value along y, hue and alpha are kept
+		mouseFrame(b, rl.Vector2{X: 50, Y: 50}, true)
+		if got, want := ColorPanel(bounds, red), (rl.Color{R: 127, G: 63, B: 63, A: 200}); got != want {
+			t.Errorf("color = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("hue bar", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		hueBounds := rl.Rectangle{X: 0, Y: 0, Width: 20, Height: 360}
+		// Selector overflowing the bar can be dragged past its ends
+		for _, tt := range []struct {
+			hue, y, want float32
+		}{{180, 120, 120}, {0, -1, 0}, {359, 361, 359}} {
+			mouseFrame(b, rl.Vector2{X: 10, Y: tt.y}, true)
+			if got := ColorBarHue(hueBounds, tt.hue); got != tt.want {
+				t.Errorf("hue = %v with mouse at y %v, want %v", got, tt.y, tt.want)
+			}
+		}
+	})
+
+	t.Run("alpha bar", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		alphaBounds := rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}
+		for _, tt := range []struct {
+			alpha, x, want float32
+		}{{0.5, 25, 0.25}, {0, -1, 0}, {1, 101, 1}} {
+			mouseFrame(b, rl.Vector2{X: tt.x, Y: 10}, true)
+			if got := ColorBarAlpha(alphaBounds, tt.alpha); got != tt.want {
+				t.Errorf("alpha = %v with mouse at x %v, want %v", got, tt.x, tt.want)
+			}
+		}
+	})
+
+	t.Run("picker", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Hue bar is placed right of the panel
+		hueX := bounds.Width + float32(GetStyle(ColorPickerControl, HueBarPadding)) + float32(GetStyle(ColorPickerControl, HueBarWidth))/2
+		mouseFrame(b, rl.Vector2{X: hueX, Y: 40}, true)
+		if got, want := ColorPicker(bounds, red), (rl.Color{R: 0, G: 255, B: 102, A: 200}); got != want {
+			t.Errorf("color = %v picking hue 144, want %v", got, want)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 75, Y: 25}, true)
+		if got, want := ColorPicker(bounds, red), (rl.Color{R: 191, G: 47, B: 47, A: 200}); got != want {
+			t.Errorf("color = %v picking from panel, want %v", got, want)
+		}
+
+		mouseFrame(b, rl.Vector2{X: 500, Y: 500}, true)
+		if got := ColorPicker(bounds, red); got != red {
+			t.Errorf("color = %v pressing outside, want %v", got, red)
+		}
+	})
+}
+
+func TestMessageBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 120}
+	closeButton := rl.Vector2{X: 188, Y: 12}
+	buttonY := bounds.Height - MessageBoxButtonPadding - MessageBoxButtonHeight/2
+
+	for _, tt := range []struct {
+		name  string
+		mouse rl.Vector2
+		want  int
+	}{
+		{"close", closeButton, 0},
+		{"first button", rl.Vector2{X: 50, Y: buttonY}, 1},
+		{"second button", rl.Vector2{X: 150, Y: buttonY}, 2},
+		{"message", rl.Vector2{X: 100, Y: 50}, -1},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			result := -1
+			click(b, tt.mouse, func() {
+				if clicked := MessageBox(bounds, "Title", "Message", "Yes;No"); clicked >= 0 {
+					result = clicked
+				}
+			})
+			if result != tt.want {
+				t.Errorf("clicked = %d, want %d", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestTextInputBox(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 140}
+	closeButton := rl.Vector2{X: 188, Y: 12}
+	textBox := rl.Vector2{X: 100, Y: 58}
+	buttonY := bounds.Height - TextInputBoxButtonPadding - TextInputBoxButtonHeight/2
+
+	t.Run("typing", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text, result := "", -1
+		frame := func() {
+			var clicked int
+			if text, clicked = TextInputBox(bounds, "Title", "", "OK;Cancel", text); clicked >= 0 {
+				result = clicked
+			}
+		}
+
+		click(b, textBox, frame)
+		for _, r := range "name" {
+			b.NextFrame()
+			b.TypeText(string(r))
+			frame()
+		}
+		if text != "name" {
+			t.Errorf("text = %q, want %q", text, "name")
+		}
+
+		click(b, rl.Vector2{X: 150, Y: buttonY}, frame)
+		if result != 2 || text != "name" {
+			t.Errorf("clicked, text = %d, %q, want 2, %q", result, text, "name")
+		}
+	})
+
+	t.Run("empty buttons", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		result := -1
+		click(b, closeButton, func() {
+			if _, clicked := TextInputBox(bounds, "Title", "Message", "", ""); clicked >= 0 {
+				result = clicked
+			}
+		})
+		if result != 0 {
+			t.Errorf("clicked = %d, want 0 for close button", result)
+		}
+		for _, call := range b.DrawCalls {
+			if math.IsInf(float64(call.Rec.Width), 0) || math.IsNaN(float64(call.Rec.Width)) {
+				t.Fatalf("draw call %v has invalid width", call)
+			}
+		}
+	})
+}
+
+func TestTextSplit(t *testing.T) {
+	for _, tt := range []struct {
+		text  string
+		items []string
+		rows  []int
+	}{
+		{"One", []string{"One"}, []int{0}},
+		{"One;Two;Three", []string{"One", "Two", "Three"}, []int{0, 0, 0}},
+		{"One;Two\nThree", []string{"One", "Two", "Three"}, []int{0, 0, 1}},
+		{"One;", []string{"One", ""}, []int{0, 0}},
+		{";One", []string{"", "One"}, []int{0, 0}},
+		{"", []string{""}, []int{0}},
+	} {
+		var count int
+		rows := make([]int, TextSplitMaxTextElements)
+		items := TextSplit(tt.text, &count, rows)
+
+		// Last item ends with the text, not with the rest of the split buffer
+		if count != len(tt.items) || strings.Join(items, "|") != strings.Join(tt.items, "|") {
+			t.Errorf("TextSplit(%q) = %q, want %q", tt.text, items, tt.items)
+		}
+		for i, row := range tt.rows {
+			if rows[i] != row {
+				t.Errorf("TextSplit(%q) rows = %v, want %v", tt.text, rows[:len(tt.rows)], tt.rows)
+				break
+			}
+		}
+	}
+}
+
+func TestConvertHSV(t *testing.T) {
+	near := func(a, b rl.Vector3) bool {
+		const epsilon = 0.0001
+		return math.Abs(float64(a.X-b.X)) < epsilon && math.Abs(float64(a.Y-b.Y)) < epsilon && math.Abs(float64(a.Z-b.Z)) < epsilon
+	}
+
+	for _, tt := range []struct {
+		name string
+		rgb  rl.Vector3
+		hsv  rl.Vector3
+	}{
+		{"red", rl.Vector3{X: 1, Y: 0, Z: 0}, rl.Vector3{X: 0, Y: 1, Z: 1}},
+		{"green", rl.Vector3{X: 0, Y: 1, Z: 0}, rl.Vector3{X: 120, Y: 1, Z: 1}},
+		{"blue", rl.Vector3{X: 0, Y: 0, Z: 1}, rl.Vector3{X: 240, Y: 1, Z: 1}},
+		{"yellow", rl.Vector3{X: 1, Y: 1, Z: 0}, rl.Vector3{X: 60, Y: 1, Z: 1}},
+		{"cyan", rl.Vector3{X: 0, Y: 1, Z: 1}, rl.Vector3{X: 180, Y: 1, Z: 1}},
+		{"magenta", rl.Vector3{X: 1, Y: 0, Z: 1}, rl.Vector3{X: 300, Y: 1, Z: 1}},
+		{"dark orange", rl.Vector3{X: 0.5, Y: 0.25, Z: 0}, rl.Vector3{X: 30, Y: 1, Z: 0.5}},
+		{"pale blue", rl.Vector3{X: 0.5, Y: 0.5, Z: 1}, rl.Vector3{X: 240, Y: 0.5, Z: 1}},
+		{"black", rl.Vector3{X: 0, Y: 0, Z: 0}, rl.Vector3{X: 0, Y: 0, Z: 0}},
+		{"gray", rl.Vector3{X: 0.5, Y: 0.5, Z: 0.5}, rl.Vector3{X: 0, Y: 0, Z: 0.5}},
+		{"white", rl.Vector3{X: 1, Y: 1, Z: 1}, rl.Vector3{X: 0, Y: 0, Z: 1}},
+	} {
+		if got := ConvertRGBtoHSV(tt.rgb); !near(got, tt.hsv) {
+			t.Errorf("%s: ConvertRGBtoHSV(%v) = %v, want %v", tt.name, tt.rgb, got, tt.hsv)
+		}
+		if got := ConvertHSVtoRGB(tt.hsv); !near(got, tt.rgb) {
+			t.Errorf("%s: ConvertHSVtoRGB(%v) = %v, want %v", tt.name, tt.hsv, got, tt.rgb)
+		}
+	}
+
+	// Gray ignores hue, hue 360 is the same as 0
+	for _, tt := range []struct {
+		hsv rl.Vector3
+		rgb rl.Vector3
+	}{
+		{rl.Vector3{X: 200, Y: 0, Z: 0.25}, rl.Vector3{X: 0.25, Y: 0.25, Z: 0.25}},
+		{rl.Vector3{X: 360, Y: 1, Z: 1}, rl.Vector3{X: 1, Y: 0, Z: 0}},
+		{rl.Vector3{X: 360, Y: 0.5, Z: 0.8}, ConvertHSVtoRGB(rl.Vector3{X: 0, Y: 0.5, Z: 0.8})},
+	} {
+		if got := ConvertHSVtoRGB(tt.hsv); !near(got, tt.rgb) {
+			t.Errorf("ConvertHSVtoRGB(%v) = %v, want %v", tt.hsv, got, tt.rgb)
+		}
+	}
+
+	// Every 8-bit color survives a round trip
+	for r := 0; r < 256; r += 15 {
+		for g := 0; g < 256; g += 15 {
+			for b := 0; b < 256; b += 15 {
+				rgb := rl.Vector3{X: float32(r) / 255, Y: float32(g) / 255, Z: float32(b) / 255}
+				if got := ConvertHSVtoRGB(ConvertRGBtoHSV(rgb)); !near(got, rgb) {
+					t.Fatalf("round trip of %v = %v", rgb, got)
+				}
+			}
+		}
+	}
+}
+
+func TestTextBoxMulti(t *testing.T) {
+	// Headless glyphs are 5 pixels wide with 1 pixel spacing, so 10 characters
+	// fit in the 60 pixels left by border and inner padding, lines are 15 pixels
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 70, Height: 200}
+
+	drawnLines := func(b *HeadlessBackend) []string {
+		var lines []string
+		for _, call := range b.DrawCallsOf(DrawTextCall) {
+			lines = append(lines, call.Text)
+		}
+		return lines
+	}
+
+	t.Run("word wrap", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		b.NextFrame()
+		TextBoxMulti(bounds, "hello world foo\nbar", 64, nil, nil, false)
+		if got, want := strings.Join(drawnLines(b), "|"), "hello |world foo|bar"; got != want {
+			t.Errorf("lines = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("long word", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		b.NextFrame()
+		TextBoxMulti(bounds, "abcdefghijklmnop qr", 64, nil, nil, false)
+		if got, want := strings.Join(drawnLines(b), "|"), "abcdefghij|klmnop qr"; got != want {
+			t.Errorf("lines = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("scrolling", func(t *testing.T) {
+		b := newTestBackend(t)
+		small := rl.Rectangle{X: 0, Y: 0, Width: 70, Height: 60}
+		text := "1\n2\n3\n4\n5\n6\n7\n8"
+
+		// Cursor at the end scrolls the last line into view
+		var scroll rl.Vector2
+		cursor := len(text)
+		b.NextFrame()
+		TextBoxMulti(small, text, 64, &scroll, &cursor, true)
+		b.NextFrame()
+		TextBoxMulti(small, text, 64, &scroll, &cursor, true)
+
+		calls := b.DrawCallsOf(DrawTextCall)
+		last := calls[len(calls)-1]
+		if scroll.Y >= 0 || last.Text != "8" || last.Position.Y < small.Y || last.Position.Y+10 > small.Y+small.Height {
+			t.Errorf("scroll = %v, last line %q at %v, want last line inside bounds", scroll, last.Text, last.Position)
+		}
+
+		// Moving the cursor to the first line scrolls it back into view
+		cursor = 0
+		b.NextFrame()
+		TextBoxMulti(small, text, 64, &scroll, &cursor, true)
+		if first := b.DrawCallsOf(DrawTextCall)[0]; first.Text != "1" || first.Position.Y < small.Y {
+			t.Errorf("scroll = %v, first line %q at %v, want first line inside bounds", scroll, first.Text, first.Position)
+		}
+	})
+
+	t.Run("line navigation", func(t *testing.T) {
+		b := newTestBackend(t)
+		text := "hello world foo"
+
+		cursor := 2
+		for _, tt := range []struct {
+			key  int32
+			want int
+		}{
+			{rl.KeyDown, 8},  // Same position on next wrapped line: "wo|rld foo"
+			{rl.KeyDown, 8},  // Already on last line
+			{rl.KeyUp, 2},    // Back to "he|llo "
+			{rl.KeyUp, 2},    // Already on first line
+			{rl.KeyEnd, 5},   // Stays before the space the line is wrapped on
+			{rl.KeyHome, 0},  // Start of first line
+			{rl.KeyDown, 6},  // Start of second line
+			{rl.KeyEnd, 15},  // End of text
+			{rl.KeyHome, 6},  // Start of second line
+			{rl.KeyLeft, 5},  // Before the space ending the first line
+			{rl.KeyRight, 6}, // Back at start of second line
+		} {
+			pressKeys(b, tt.key)
+			if TextBoxMulti(bounds, text, 64, nil, &cursor, true); cursor != tt.want {
+				t.Fatalf("key %d: cursor = %d, want %d", tt.key, cursor, tt.want)
+			}
+		}
+	})
+}
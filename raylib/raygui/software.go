@@ -0,0 +1,346 @@
+package raygui
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"unicode/utf8"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Software rasterizer backend, renders gui frames to an image without OpenGL
+// NOTE: Input scripting and draw call recording come from HeadlessBackend.
+// Text is always drawn and measured with the embedded raylib default font,
+// textures are only drawn once their pixels are provided with SetTextureImage()
+type SoftwareBackend struct {
+	*HeadlessBackend
+
+	Image *image.RGBA // Rendered frame
+
+	clip     image.Rectangle // Current scissor area
+	textures map[uint32]image.Image
+}
+
+var _ Backend = (*SoftwareBackend)(nil)
+
+// Create software backend rendering to an image of given size
+func NewSoftwareBackend(width, height int) *SoftwareBackend {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	return &SoftwareBackend{
+		HeadlessBackend: NewHeadlessBackend(),
+		Image:           img,
+		clip:            img.Bounds(),
+		textures:        map[uint32]image.Image{},
+	}
+}
+
+// Clear rendered frame with color
+func (b *SoftwareBackend) Clear(color rl.Color) {
+	c := toRGBA(color)
+	for i := 0; i < len(b.Image.Pix); i += 4 {
+		b.Image.Pix[i+0] = c.R
+		b.Image.Pix[i+1] = c.G
+		b.Image.Pix[i+2] = c.B
+		b.Image.Pix[i+3] = c.A
+	}
+}
+
+// Save rendered frame as PNG
+func (b *SoftwareBackend) SavePNG(w io.Writer) error {
+	return png.Encode(w, b.Image)
+}
+
+// Set texture pixels used to draw texture, nil removes them
+func (b *SoftwareBackend) SetTextureImage(texture rl.Texture2D, img image.Image) {
+	if img == nil {
+		delete(b.textures, texture.ID)
+		return
+	}
+	b.textures[texture.ID] = img
+}
+
+// Convert color to premultiplied alpha
+func toRGBA(c rl.Color) color.RGBA {
+	a := uint32(c.A)
+	return color.RGBA{
+		R: uint8(uint32(c.R) * a / 255),
+		G: uint8(uint32(c.G) * a / 255),
+		B: uint8(uint32(c.B) * a / 255),
+		A: uint8(a),
+	}
+}
+
+// Blend premultiplied color over pixel, if inside scissor area
+func (b *SoftwareBackend) blend(x, y int, c color.RGBA) {
+	if c.A == 0 || !(image.Point{x, y}).In(b.clip) {
+		return
+	}
+
+	i := b.Image.PixOffset(x, y)
+	pix := b.Image.Pix[i : i+4 : i+4]
+	inv := 255 - uint32(c.A)
+	pix[0] = uint8(uint32(c.R) + uint32(pix[0])*inv/255)
+	pix[1] = uint8(uint32(c.G) + uint32(pix[1])*inv/255)
+	pix[2] = uint8(uint32(c.B) + uint32(pix[2])*inv/255)
+	pix[3] = uint8(uint32(c.A) + uint32(pix[3])*inv/255)
+}
+
+// Get pixels area covered by rectangle, limited to scissor area
+func (b *SoftwareBackend) area(posX, posY, width, height int32) image.Rectangle {
+	return image.Rect(int(posX), int(posY), int(posX)+int(width), int(posY)+int(height)).Intersect(b.clip)
+}
+
+func (b *SoftwareBackend) DrawRectangle(posX, posY, width, height int32, color rl.Color) {
+	b.HeadlessBackend.DrawRectangle(posX, posY, width, height, color)
+
+	if width <= 0 || height <= 0 {
+		return
+	}
+
+	c := toRGBA(color)
+	area := b.area(posX, posY, width, height)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			b.blend(x, y, c)
+		}
+	}
+}
+
+func (b *SoftwareBackend) DrawRectangleGradientV(posX, posY, width, height int32, color1, color2 rl.Color) {
+	b.HeadlessBackend.DrawRectangleGradientV(posX, posY, width, height, color1, color2)
+
+	rec := rl.Rectangle{X: float32(posX), Y: float32(posY), Width: float32(width), Height: float32(height)}
+	b.drawGradient(rec, color1, color2, color2, color1)
+}
+
+func (b *SoftwareBackend) DrawRectangleGradientEx(rec rl.Rectangle, color1, color2, color3, color4 rl.Color) {
+	b.HeadlessBackend.DrawRectangleGradientEx(rec, color1, color2, color3, color4)
+
+	b.drawGradient(rec, color1, color2, color3, color4)
+}
+
+// Draw gradient rectangle, colors are given for top-left, bottom-left, bottom-right and top-right corners
+// NOTE: Colors are interpolated bilinearly at pixel centers
+func (b *SoftwareBackend) drawGradient(rec rl.Rectangle, topLeft, bottomLeft, bottomRight, topRight rl.Color) {
+	if rec.Width <= 0 || rec.Height <= 0 {
+		return
+	}
+
+	lerp := func(a, b uint8, t float32) float32 {
+		return float32(a) + (float32(b)-float32(a))*t
+	}
+	mix := func(c1, c2 rl.Color, t float32) [4]float32 {
+		return [4]float32{lerp(c1.R, c2.R, t), lerp(c1.G, c2.G, t), lerp(c1.B, c2.B, t), lerp(c1.A, c2.A, t)}
+	}
+
+	area := image.Rect(int(floor32(rec.X)), int(floor32(rec.Y)), int(math.Ceil(float64(rec.X+rec.Width))), int(math.Ceil(float64(rec.Y+rec.Height)))).Intersect(b.clip)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		ty := clamp01((float32(y) + 0.5 - rec.Y) / rec.Height)
+		left := mix(topLeft, bottomLeft, ty)
+		right := mix(topRight, bottomRight, ty)
+
+		for x := area.Min.X; x < area.Max.X; x++ {
+			tx := clamp01((float32(x) + 0.5 - rec.X) / rec.Width)
+
+			var c rl.Color
+			c.R = uint8(round32(left[0] + (right[0]-left[0])*tx))
+			c.G = uint8(round32(left[1] + (right[1]-left[1])*tx))
+			c.B = uint8(round32(left[2] + (right[2]-left[2])*tx))
+			c.A = uint8(round32(left[3] + (right[3]-left[3])*tx))
+			b.blend(x, y, toRGBA(c))
+		}
+	}
+}
+
+func clamp01(f float32) float32 {
+	if f < 0 {
+		return 0
+	} else if f > 1 {
+		return 1
+	}
+	return f
+}
+
+// NOTE: As raylib, only triangles with vertex in counter-clockwise order are drawn
+func (b *SoftwareBackend) DrawTriangle(v1, v2, v3 rl.Vector2, color rl.Color) {
+	b.HeadlessBackend.DrawTriangle(v1, v2, v3, color)
+
+	// Edge function, negative when point is at the inner side of a counter-clockwise edge
+	edge := func(a, b rl.Vector2, x, y float32) float32 {
+		return (b.X-a.X)*(y-a.Y) - (b.Y-a.Y)*(x-a.X)
+	}
+
+	if edge(v1, v2, v3.X, v3.Y) >= 0 {
+		return
+	}
+
+	minX := floor32(float32(math.Min(float64(v1.X), math.Min(float64(v2.X), float64(v3.X)))))
+	maxX := float32(math.Ceil(math.Max(float64(v1.X), math.Max(float64(v2.X), float64(v3.X)))))
+	minY := floor32(float32(math.Min(float64(v1.Y), math.Min(float64(v2.Y), float64(v3.Y)))))
+	maxY := float32(math.Ceil(math.Max(float64(v1.Y), math.Max(float64(v2.Y), float64(v3.Y)))))
+
+	c := toRGBA(color)
+	area := image.Rect(int(minX), int(minY), int(maxX), int(maxY)).Intersect(b.clip)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			// Sample at pixel center
+			px, py := float32(x)+0.5, float32(y)+0.5
+			if edge(v1, v2, px, py) <= 0 && edge(v2, v3, px, py) <= 0 && edge(v3, v1, px, py) <= 0 {
+				b.blend(x, y, c)
+			}
+		}
+	}
+}
+
+func (b *SoftwareBackend) DrawTextureRec(texture rl.Texture2D, sourceRec rl.Rectangle, position rl.Vector2, tint rl.Color) {
+	b.HeadlessBackend.DrawTextureRec(texture, sourceRec, position, tint)
+
+	img, ok := b.textures[texture.ID]
+	if !ok {
+		return
+	}
+
+	width := int(math.Abs(float64(sourceRec.Width)))
+	height := int(math.Abs(float64(sourceRec.Height)))
+	origin := image.Pt(int(floor32(position.X)), int(floor32(position.Y)))
+	area := image.Rect(origin.X, origin.Y, origin.X+width, origin.Y+height).Intersect(b.clip)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		for x := area.Min.X; x < area.Max.X; x++ {
+			// Negative source size flips texture, as raylib does
+			sx := x - origin.X
+			if sourceRec.Width < 0 {
+				sx = width - 1 - sx
+			}
+			sy := y - origin.Y
+			if sourceRec.Height < 0 {
+				sy = height - 1 - sy
+			}
+
+			r, g, bl, a := img.At(int(sourceRec.X)+sx, int(sourceRec.Y)+sy).RGBA()
+
+			// Texture color is premultiplied (0..0xffff), tint is applied with its alpha
+			tinted := func(v uint32, t uint8) uint8 {
+				return uint8(uint64(v) * uint64(t) * uint64(tint.A) / (0xffff * 255))
+			}
+			b.blend(x, y, color.RGBA{
+				R: tinted(r, tint.R),
+				G: tinted(g, tint.G),
+				B: tinted(bl, tint.B),
+				A: tinted(a, 255),
+			})
+		}
+	}
+}
+
+func (b *SoftwareBackend) BeginScissorMode(x, y, width, height int32) {
+	b.HeadlessBackend.BeginScissorMode(x, y, width, height)
+
+	b.clip = image.Rect(int(x), int(y), int(x)+int(width), int(y)+int(height)).Intersect(b.Image.Bounds())
+}
+
+func (b *SoftwareBackend) EndScissorMode() {
+	b.HeadlessBackend.EndScissorMode()
+
+	b.clip = b.Image.Bounds()
+}
+
+func (b *SoftwareBackend) GetFontDefault() rl.Font {
+	return rl.Font{BaseSize: softwareFontHeight, CharsCount: int32(len(softwareFontCharsWidth))}
+}
+
+// NOTE: Measured as raylib MeasureTextEx() with the embedded font, font parameter is ignored
+func (b *SoftwareBackend) MeasureTextEx(font rl.Font, text string, fontSize float32, spacing float32) rl.Vector2 {
+	scaleFactor := fontSize / softwareFontHeight
+
+	textWidth := 0
+	maxWidth := 0
+	textHeight := float32(softwareFontHeight)
+	lenCounter := 0
+	maxLen := 0
+
+	for _, codepoint := range text {
+		lenCounter++
+
+		if codepoint != '\n' {
+			textWidth += softwareFontCharsWidth[softwareFontGlyph(codepoint)]
+		} else {
+			if maxWidth < textWidth {
+				maxWidth = textWidth
+			}
+			lenCounter = 0
+			textWidth = 0
+			textHeight += softwareFontHeight * 1.5 // NOTE: Fixed line spacing of 1.5 lines
+		}
+
+		if maxLen < lenCounter {
+			maxLen = lenCounter
+		}
+	}
+
+	if maxWidth < textWidth {
+		maxWidth = textWidth
+	}
+
+	return rl.Vector2{X: float32(maxWidth)*scaleFactor + float32(maxLen-1)*spacing, Y: textHeight * scaleFactor}
+}
+
+// NOTE: Drawn as raylib DrawTextEx() with the embedded font, font parameter is ignored
+func (b *SoftwareBackend) DrawTextEx(font rl.Font, text string, position rl.Vector2, fontSize float32, spacing float32, tint rl.Color) {
+	b.HeadlessBackend.DrawTextEx(font, text, position, fontSize, spacing, tint)
+
+	scaleFactor := fontSize / softwareFontHeight
+	c := toRGBA(tint)
+
+	var textOffsetX, textOffsetY float32
+	for i := 0; i < len(text); {
+		codepoint, size := utf8.DecodeRuneInString(text[i:])
+		i += size
+
+		if codepoint == '\n' {
+			// NOTE: Fixed line spacing of 1.5 line-height
+			textOffsetY += float32(int((softwareFontHeight + softwareFontHeight/2) * scaleFactor))
+			textOffsetX = 0
+			continue
+		}
+
+		glyph := softwareFontRecs[softwareFontGlyph(codepoint)]
+		if codepoint != ' ' && codepoint != '\t' {
+			b.drawGlyph(glyph, rl.Vector2{X: position.X + textOffsetX, Y: position.Y + textOffsetY}, scaleFactor, c)
+		}
+
+		textOffsetX += float32(glyph.Dx())*scaleFactor + spacing
+	}
+}
+
+// Draw glyph from font atlas scaled by factor, sampling nearest atlas pixel
+func (b *SoftwareBackend) drawGlyph(glyph image.Rectangle, position rl.Vector2, scaleFactor float32, c color.RGBA) {
+	if scaleFactor <= 0 {
+		return
+	}
+
+	width := float32(glyph.Dx()) * scaleFactor
+	height := float32(glyph.Dy()) * scaleFactor
+
+	area := image.Rect(int(floor32(position.X)), int(floor32(position.Y)), int(math.Ceil(float64(position.X+width))), int(math.Ceil(float64(position.Y+height)))).Intersect(b.clip)
+	for y := area.Min.Y; y < area.Max.Y; y++ {
+		sy := int(floor32((float32(y) + 0.5 - position.Y) / scaleFactor))
+		if sy < 0 || sy >= glyph.Dy() {
+			continue
+		}
+
+		for x := area.Min.X; x < area.Max.X; x++ {
+			sx := int(floor32((float32(x) + 0.5 - position.X) / scaleFactor))
+			if sx < 0 || sx >= glyph.Dx() {
+				continue
+			}
+
+			if softwareFontPixel(glyph.Min.X+sx, glyph.Min.Y+sy) {
+				b.blend(x, y, c)
+			}
+		}
+	}
+}
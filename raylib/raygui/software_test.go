@@ -0,0 +1,166 @@
+package raygui
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func pixelAt(b *SoftwareBackend, x, y int) color.RGBA {
+	return b.Image.RGBAAt(x, y)
+}
+
+func TestSoftwareRectangle(t *testing.T) {
+	b := NewSoftwareBackend(16, 16)
+	b.Clear(rl.White)
+	b.DrawRectangle(2, 3, 4, 5, rl.Red)
+
+	if got := pixelAt(b, 2, 3); got != (color.RGBA{230, 41, 55, 255}) {
+		t.Errorf("inside pixel = %v, want red", got)
+	}
+	if got := pixelAt(b, 5, 7); got != (color.RGBA{230, 41, 55, 255}) {
+		t.Errorf("last inside pixel = %v, want red", got)
+	}
+	for _, p := range []image.Point{{1, 3}, {6, 3}, {2, 2}, {2, 8}} {
+		if got := pixelAt(b, p.X, p.Y); got != (color.RGBA{255, 255, 255, 255}) {
+			t.Errorf("pixel %v = %v, want white", p, got)
+		}
+	}
+	if len(b.DrawCallsOf(DrawRectangleCall)) != 1 {
+		t.Error("draw call not recorded")
+	}
+}
+
+func TestSoftwareBlending(t *testing.T) {
+	b := NewSoftwareBackend(1, 1)
+	b.Clear(rl.White)
+	b.DrawRectangle(0, 0, 1, 1, rl.Color{A: 128})
+
+	if got := pixelAt(b, 0, 0); got != (color.RGBA{127, 127, 127, 255}) {
+		t.Errorf("pixel = %v, want half black over white", got)
+	}
+}
+
+func TestSoftwareTriangle(t *testing.T) {
+	// Counter-clockwise on screen, as DropdownBox() arrow
+	v1, v2, v3 := rl.Vector2{X: 0, Y: 0}, rl.Vector2{X: 8, Y: 8}, rl.Vector2{X: 16, Y: 0}
+
+	b := NewSoftwareBackend(16, 16)
+	b.DrawTriangle(v1, v2, v3, rl.Black)
+	if got := pixelAt(b, 8, 2); got.A != 255 {
+		t.Errorf("pixel inside triangle = %v, want black", got)
+	}
+	if got := pixelAt(b, 8, 12); got.A != 0 {
+		t.Errorf("pixel outside triangle = %v, want empty", got)
+	}
+
+	// Clockwise triangles are culled
+	b = NewSoftwareBackend(16, 16)
+	b.DrawTriangle(v1, v3, v2, rl.Black)
+	if got := pixelAt(b, 8, 2); got.A != 0 {
+		t.Errorf("clockwise triangle drawn: pixel = %v", got)
+	}
+}
+
+func TestSoftwareScissor(t *testing.T) {
+	b := NewSoftwareBackend(16, 16)
+	b.BeginScissorMode(4, 4, 4, 4)
+	b.DrawRectangle(0, 0, 16, 16, rl.Black)
+	b.EndScissorMode()
+
+	if got := pixelAt(b, 5, 5); got.A != 255 {
+		t.Errorf("pixel inside scissor = %v, want black", got)
+	}
+	if got := pixelAt(b, 3, 5); got.A != 0 {
+		t.Errorf("pixel outside scissor = %v, want empty", got)
+	}
+
+	b.DrawRectangle(0, 0, 1, 1, rl.Black)
+	if got := pixelAt(b, 0, 0); got.A != 255 {
+		t.Error("scissor not reset by EndScissorMode()")
+	}
+}
+
+func TestSoftwareText(t *testing.T) {
+	b := NewSoftwareBackend(32, 16)
+
+	// Glyphs width from raylib default font: 'H' is 6 pixels, 'i' is 1 pixel
+	if got := b.MeasureTextEx(b.GetFontDefault(), "Hi", 10, 1); got != (rl.Vector2{X: 8, Y: 10}) {
+		t.Errorf("text size = %v, want {8 10}", got)
+	}
+	if got := b.MeasureTextEx(b.GetFontDefault(), "Hi", 20, 1); got != (rl.Vector2{X: 15, Y: 20}) {
+		t.Errorf("scaled text size = %v, want {15 20}", got)
+	}
+
+	b.DrawTextEx(b.GetFontDefault(), "H", rl.Vector2{X: 0, Y: 0}, 10, 1, rl.Black)
+	covered := 0
+	for y := 0; y < 10; y++ {
+		for x := 0; x < 6; x++ {
+			if pixelAt(b, x, y).A != 0 {
+				covered++
+			}
+		}
+	}
+	if covered == 0 {
+		t.Error("no glyph pixels drawn")
+	}
+	for y := 0; y < 16; y++ {
+		for x := 6; x < 32; x++ {
+			if pixelAt(b, x, y).A != 0 {
+				t.Fatalf("pixel %d,%d drawn outside glyph", x, y)
+			}
+		}
+	}
+}
+
+func TestSoftwareTexture(t *testing.T) {
+	texture := rl.Texture2D{ID: 7, Width: 2, Height: 1}
+	pixels := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	pixels.Set(0, 0, color.RGBA{255, 0, 0, 255})
+	pixels.Set(1, 0, color.RGBA{0, 0, 255, 255})
+
+	b := NewSoftwareBackend(4, 4)
+	b.DrawTextureRec(texture, rl.Rectangle{Width: 2, Height: 1}, rl.Vector2{X: 1, Y: 1}, rl.White)
+	if got := pixelAt(b, 1, 1); got.A != 0 {
+		t.Errorf("texture without pixels drawn: pixel = %v", got)
+	}
+
+	b.SetTextureImage(texture, pixels)
+	b.DrawTextureRec(texture, rl.Rectangle{Width: -2, Height: 1}, rl.Vector2{X: 1, Y: 1}, rl.White)
+	if got := pixelAt(b, 1, 1); got != (color.RGBA{0, 0, 255, 255}) {
+		t.Errorf("flipped texture pixel = %v, want blue", got)
+	}
+	if got := pixelAt(b, 2, 1); got != (color.RGBA{255, 0, 0, 255}) {
+		t.Errorf("flipped texture pixel = %v, want red", got)
+	}
+}
+
+func TestSoftwareRenderControls(t *testing.T) {
+	b := NewSoftwareBackend(120, 40)
+	useTestContext(t, b)
+
+	b.NextFrame()
+	b.Clear(rl.GetColor(int32(GetStyle(Default, BackgroundColorProp))))
+	Button(rl.Rectangle{X: 10, Y: 10, Width: 100, Height: 20}, IconText(IconFileSave, "Save"))
+
+	// Button border is drawn with normal border color
+	if got, want := pixelAt(b, 10, 20), toRGBA(styleColor(ButtonControl, BorderColorNormalProp)); got != want {
+		t.Errorf("border pixel = %v, want %v", got, want)
+	}
+
+	var buf bytes.Buffer
+	if err := b.SavePNG(&buf); err != nil {
+		t.Fatal(err)
+	}
+	img, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != b.Image.Bounds() {
+		t.Errorf("png bounds = %v, want %v", img.Bounds(), b.Image.Bounds())
+	}
+}
@@ -0,0 +1,172 @@
+package raygui
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Build binary style file with properties, followed by extra data like font data
+func rgsFile(signature string, propertyCount int32, properties []rgsProperty, extra ...interface{}) []byte {
+	var buf bytes.Buffer
+	header := rgsHeader{Version: rgsVersion, PropertyCount: propertyCount}
+	copy(header.Signature[:], signature)
+	binary.Write(&buf, binary.LittleEndian, header)
+	binary.Write(&buf, binary.LittleEndian, properties)
+	for _, data := range extra {
+		binary.Write(&buf, binary.LittleEndian, data)
+	}
+	return buf.Bytes()
+}
+
+func TestLoadStyleText(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		newTestBackend(t)
+
+		text := "#\n# rgs style text file\n#\np 00 00 0x11223344    DEFAULT_BORDER_COLOR_NORMAL\r\n\np 02 20 0x00001234\n"
+		if err := LoadStyleFromReader(strings.NewReader(text)); err != nil {
+			t.Fatal(err)
+		}
+
+		// DEFAULT properties are propagated to all controls
+		if got := GetStyle(ButtonControl, BorderColorNormalProp); got != 0x11223344 {
+			t.Errorf("button border color = %#x, want DEFAULT value 0x11223344", got)
+		}
+		if got := GetStyle(ButtonControl, 20); got != 0x1234 {
+			t.Errorf("button extended property = %#x, want 0x1234", got)
+		}
+	})
+
+	for _, tt := range []struct {
+		name string
+		text string
+	}{
+		{"empty", ""},
+		{"truncated property", "#\np 00 00 0x11223344\np 01 02"},
+		{"invalid property value", "#\np 00 00 0x11223344\np 01 02 red\n"},
+		{"invalid control id", "#\np 00 00 0x11223344\np 16 02 0x00000001\n"},
+		{"invalid property id", "#\np 00 00 0x11223344\np 01 24 0x00000001\n"},
+		{"negative property id", "#\np 00 00 0x11223344\np 01 -1 0x00000001\n"},
+		{"font without file", "#\np 00 00 0x11223344\nf 16 0\n"},
+		{"invalid font size", "#\np 00 00 0x11223344\nf big 0 font.ttf\n"},
+		{"missing charmap", "#\np 00 00 0x11223344\nf 16 missing_charmap.txt font.ttf\n"},
+		{"property after invalid font", "#\nf 16 missing_charmap.txt font.ttf\np 00 00 0x11223344\n"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestBackend(t)
+			style := defaultContext.style
+
+			if err := LoadStyleFromReader(strings.NewReader(tt.text)); err == nil {
+				t.Fatal("no error loading invalid style")
+			}
+
+			// Valid lines before the error are not applied
+			if defaultContext.style != style {
+				t.Error("invalid style file changed style")
+			}
+		})
+	}
+}
+
+func TestLoadStyleBinary(t *testing.T) {
+	properties := []rgsProperty{{0, int16(BorderColorNormalProp), 0x11223344}, {int16(ButtonControl), 20, 0x1234}}
+
+	for _, tt := range []struct {
+		name string
+		data []byte
+	}{
+		{"without font data", rgsFile(rgsSignature, 2, properties)},
+		{"empty font data", rgsFile(rgsSignature, 2, properties, int32(0))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestBackend(t)
+
+			if err := LoadStyleFromReader(bytes.NewReader(tt.data)); err != nil {
+				t.Fatal(err)
+			}
+			if got := GetStyle(ButtonControl, BorderColorNormalProp); got != 0x11223344 {
+				t.Errorf("button border color = %#x, want DEFAULT value 0x11223344", got)
+			}
+			if got := GetStyle(ButtonControl, 20); got != 0x1234 {
+				t.Errorf("button extended property = %#x, want 0x1234", got)
+			}
+		})
+	}
+
+	fontInfo := func(glyphCount int32) rgsFontInfo { return rgsFontInfo{BaseSize: 10, GlyphCount: glyphCount} }
+	for _, tt := range []struct {
+		name string
+		data []byte
+	}{
+		{"truncated header", rgsFile(rgsSignature, 2, nil)[:6]},
+		{"truncated properties", rgsFile(rgsSignature, 3, properties)},
+		{"truncated font data size", rgsFile(rgsSignature, 2, properties, int16(1))},
+		{"truncated font info", rgsFile(rgsSignature, 2, properties, int32(1), int32(10))},
+		{"truncated font image", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(16), rgsFontImage{2, 2, 7}, make([]byte, 8))},
+		{"truncated glyphs", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(2), int32(4), rgsFontImage{2, 2, 1}, make([]byte, 4), make([]float32, 8))},
+		{"invalid signature", rgsFile("rGI ", 2, properties)},
+		{"negative property count", rgsFile(rgsSignature, -1, properties)},
+		{"huge property count", rgsFile(rgsSignature, 0x7fffffff, properties)},
+		{"invalid control id", rgsFile(rgsSignature, 3, append(properties, rgsProperty{MaxControls, 0, 0}))},
+		{"invalid property id", rgsFile(rgsSignature, 3, append(properties, rgsProperty{0, MaxPropsDefault + MaxPropsExtended, 0}))},
+		{"zero glyph count", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(0))},
+		{"huge glyph count", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(0x7fffffff))},
+		{"huge font image", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(0x7fffffff))},
+		{"empty font image", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(4), rgsFontImage{0, 2, 1})},
+		{"font image smaller than format", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(1), rgsFontImage{4096, 4096, 7}, make([]byte, 1))},
+		{"font image larger than format", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(16), rgsFontImage{2, 2, 1}, make([]byte, 16))},
+		{"unknown font image format", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(4), rgsFontImage{2, 2, 0}, make([]byte, 4))},
+		{"huge font image format", rgsFile(rgsSignature, 2, properties, int32(1), fontInfo(1), int32(4), rgsFontImage{2, 2, 22}, make([]byte, 4))},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			newTestBackend(t)
+			style := defaultContext.style
+
+			if err := LoadStyleFromReader(bytes.NewReader(tt.data)); err == nil {
+				t.Fatal("no error loading invalid style")
+			}
+			if defaultContext.style != style {
+				t.Error("invalid style file changed style")
+			}
+		})
+	}
+}
+
+func TestSaveStyle(t *testing.T) {
+	for _, format := range []StyleFormat{StyleFormatText, StyleFormatBinary} {
+		newTestBackend(t)
+
+		SetStyle(Default, BorderColorNormalProp, 0x11223344)
+		SetStyle(LabelControl, BorderColorNormalProp, 0x55667788)
+		SetStyle(Default, TextSizeProp, 20)
+		SetStyle(ButtonControl, BorderWidthProp, 5)
+		SetStyle(ButtonControl, 20, 0x1234)
+		SetStyle(ValueBoxControl, FloatPrecision, 4)
+		defaultContext.font = rl.Font{BaseSize: 42}
+		style := defaultContext.style
+
+		var buf bytes.Buffer
+		if err := SaveStyle(&buf, format); err != nil {
+			t.Fatal(err)
+		}
+		if defaultContext.style != style || GetFont().BaseSize != 42 {
+			t.Errorf("format %d: saving changed style or font", format)
+		}
+
+		// Loading over default style gives back the saved style
+		newTestBackend(t)
+		if err := LoadStyleFromReader(&buf); err != nil {
+			t.Fatalf("format %d: %v", format, err)
+		}
+		if defaultContext.style != style {
+			for i := range style {
+				if defaultContext.style[i] != style[i] {
+					t.Errorf("format %d: control %d property %d = %#x, want %#x", format, i/(MaxPropsDefault+MaxPropsExtended), i%(MaxPropsDefault+MaxPropsExtended), defaultContext.style[i], style[i])
+				}
+			}
+		}
+	}
+}
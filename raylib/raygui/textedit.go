@@ -0,0 +1,390 @@
+package raygui
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Time in seconds a text editing key has to be held before it starts repeating
+const TextEditRepeatDelay = 0.5
+
+// Time in seconds between repeated text editing key presses while held
+const TextEditRepeatInterval = 0.05
+
+// Character drawn in place of every character of password text
+const TextBoxPasswordMask = '*'
+
+// Text box input options -- TextBoxPro()
+type TextBoxOptions struct {
+	Filter      TextFilter // Accepted text, nil accepts any text (see TextFilterInteger())
+	MaxRunes    int        // Maximum number of characters, 0 for no limit
+	Password    bool       // Draw text masked with TextBoxPasswordMask, copying text is disabled
+	Placeholder string     // Hint drawn in disabled text color while text is empty
+}
+
+// Get text drawn in text box, password text is masked
+func (o *TextBoxOptions) shown(text string) string {
+	if !o.Password {
+		return text
+	}
+
+	var count int
+	for offset := 0; offset < len(text); offset = textCharRight(text, offset) {
+		count++
+	}
+	return strings.Repeat(string(TextBoxPasswordMask), count)
+}
+
+// Convert byte offset into text to byte offset into shown text
+func (o *TextBoxOptions) shownOffset(text string, offset int) int {
+	if !o.Password {
+		return offset
+	}
+
+	var shown int
+	for i := 0; i < offset; i = textCharRight(text, i) {
+		shown += utf8.RuneLen(TextBoxPasswordMask)
+	}
+	return shown
+}
+
+// Convert byte offset into shown text to byte offset into text
+func (o *TextBoxOptions) textOffset(text string, shown int) int {
+	if !o.Password {
+		return shown
+	}
+
+	offset := 0
+	for i := 0; i < shown; i += utf8.RuneLen(TextBoxPasswordMask) {
+		offset = textCharRight(text, offset)
+	}
+	return offset
+}
+
+// Text edit state of the text box in edit mode -- TextBox()
+// NOTE: Only one text box is in edit mode at a time, so the cursor and
+// selection are kept in the context instead of by the caller
+type textEdit struct {
+	bounds rl.Rectangle // Bounds of the text box being edited
+	active bool         // Edit state belongs to a text box in edit mode
+	cursor int          // Cursor position, byte offset into text
+	anchor int          // Selection anchor, text between anchor and cursor is selected
+
+	original string // Text when edit mode started, restored by gamepad B
+
+	heldKey  int32   // Editing key held down
+	heldTime float32 // Time the editing key has been held down
+}
+
+// Get edit state for text box, starting with the cursor at the end of text
+// when another text box was being edited
+func (ctx *Context) textEditState(bounds rl.Rectangle, text string) *textEdit {
+	e := &ctx.textEdit
+	if !e.active || e.bounds != bounds {
+		*e = textEdit{bounds: bounds, active: true, cursor: len(text), anchor: len(text), original: text}
+	}
+
+	// Text could have been changed by the caller
+	e.cursor = textClampOffset(text, e.cursor)
+	e.anchor = textClampOffset(text, e.anchor)
+
+	return e
+}
+
+// Start editing text box with cursor at offset, used when a click starts edit mode
+func (ctx *Context) textEditStart(bounds rl.Rectangle, text string, offset int) {
+	ctx.textEdit = textEdit{bounds: bounds, active: true, cursor: offset, anchor: offset, original: text}
+}
+
+// End edit state of text box, next edit mode starts with a new cursor
+func (ctx *Context) textEditEnd(bounds rl.Rectangle) {
+	if ctx.textEdit.bounds == bounds {
+		ctx.textEdit.active = false
+	}
+}
+
+// Get selected text range as byte offsets, start == end if nothing is selected
+func (e *textEdit) selection() (start, end int) {
+	if e.anchor < e.cursor {
+		return e.anchor, e.cursor
+	}
+	return e.cursor, e.anchor
+}
+
+// Delete selected text, cursor is placed where the selection was
+func (e *textEdit) deleteSelection(text string) string {
+	start, end := e.selection()
+	e.cursor, e.anchor = start, start
+
+	return text[:start] + text[end:]
+}
+
+// Insert text at cursor replacing selected text, control characters and
+// characters rejected by the options filter are skipped
+// NOTE: Characters are inserted while text has less than options.MaxRunes characters and fits maxWidth
+func (ctx *Context) textEditInsert(e *textEdit, text, insert string, options *TextBoxOptions, maxWidth float32) string {
+	text = e.deleteSelection(text)
+
+	for _, r := range insert {
+		if r < 32 {
+			continue
+		}
+		if (options.MaxRunes != 0 && utf8.RuneCountInString(text) >= options.MaxRunes) || float32(ctx.GetTextWidth(options.shown(text))) >= maxWidth-float32(ctx.GetStyle(Default, TextSizeProp)) {
+			break
+		}
+
+		byteSize := 0
+		textUTF8 := CodepointToUTF8(r, &byteSize)
+		inserted := text[:e.cursor] + textUTF8 + text[e.cursor:]
+		if options.Filter != nil && !options.Filter(inserted) {
+			continue
+		}
+
+		text = inserted
+		e.cursor += byteSize
+	}
+	e.anchor = e.cursor
+
+	return text
+}
+
+// Edit text with keyboard input: cursor movement, selection, deletion and clipboard
+// NOTE: Ctrl+Left/Right move by words, Shift extends the selection while moving,
+// Ctrl+A selects all text and Ctrl+C/X/V use the clipboard
+func (ctx *Context) textEditKeys(e *textEdit, text string, options *TextBoxOptions, maxWidth float32) string {
+	ctrl := ctx.backend.IsKeyDown(rl.KeyLeftControl) || ctx.backend.IsKeyDown(rl.KeyRightControl)
+	shift := ctx.backend.IsKeyDown(rl.KeyLeftShift) || ctx.backend.IsKeyDown(rl.KeyRightShift)
+
+	// NOTE: Every repeating key is checked once per frame to keep hold time
+	left := ctx.textEditKeyPressed(rl.KeyLeft)
+	right := ctx.textEditKeyPressed(rl.KeyRight)
+	backspace := ctx.textEditKeyPressed(rl.KeyBackspace)
+	del := ctx.textEditKeyPressed(rl.KeyDelete)
+
+	start, end := e.selection()
+
+	switch {
+	case ctrl && ctx.backend.IsKeyPressed(rl.KeyA):
+		e.anchor, e.cursor = 0, len(text)
+	case ctrl && (ctx.backend.IsKeyPressed(rl.KeyC) || ctx.backend.IsKeyPressed(rl.KeyX)):
+		if start != end && !options.Password {
+			ctx.backend.SetClipboardText(text[start:end])
+			if ctx.backend.IsKeyPressed(rl.KeyX) {
+				text = e.deleteSelection(text)
+			}
+		}
+	case ctrl && ctx.backend.IsKeyPressed(rl.KeyV):
+		text = ctx.textEditInsert(e, text, ctx.backend.GetClipboardText(), options, maxWidth)
+	case backspace || del:
+		// Without selection, delete the character (or word) next to the cursor
+		if start == end {
+			switch {
+			case backspace && ctrl:
+				e.anchor = textWordLeft(text, e.cursor)
+			case backspace:
+				e.anchor = textCharLeft(text, e.cursor)
+			case ctrl:
+				e.anchor = textWordRight(text, e.cursor)
+			default:
+				e.anchor = textCharRight(text, e.cursor)
+			}
+		}
+		text = e.deleteSelection(text)
+	case left, right, ctx.backend.IsKeyPressed(rl.KeyHome), ctx.backend.IsKeyPressed(rl.KeyEnd):
+		cursor := e.cursor
+		switch {
+		case left && start != end && !shift:
+			cursor = start
+		case right && start != end && !shift:
+			cursor = end
+		case left && ctrl:
+			cursor = textWordLeft(text, cursor)
+		case left:
+			cursor = textCharLeft(text, cursor)
+		case right && ctrl:
+			cursor = textWordRight(text, cursor)
+		case right:
+			cursor = textCharRight(text, cursor)
+		case ctx.backend.IsKeyPressed(rl.KeyHome):
+			cursor = 0
+		default:
+			cursor = len(text)
+		}
+
+		e.cursor = cursor
+		if !shift {
+			e.anchor = cursor
+		}
+	}
+
+	return text
+}
+
+// Check if text editing key has been pressed, repeating while held down
+// NOTE: Call once per frame for every repeating key
+func (ctx *Context) textEditKeyPressed(key int32) bool {
+	e := &ctx.textEdit
+
+	if ctx.backend.IsKeyPressed(key) {
+		e.heldKey = key
+		e.heldTime = 0
+		return true
+	}
+
+	if e.heldKey != key {
+		return false
+	}
+	if !ctx.backend.IsKeyDown(key) {
+		e.heldKey = 0
+		return false
+	}
+
+	e.heldTime += ctx.backend.GetFrameTime()
+	if e.heldTime >= TextEditRepeatDelay {
+		e.heldTime -= TextEditRepeatInterval
+		return true
+	}
+	return false
+}
+
+// Get byte offset of the character before offset
+// NOTE: Combining marks belong to the character they follow and characters
+// joined by a zero width joiner are one character, so the cursor never ends
+// up between a letter and its accent
+func textCharLeft(text string, offset int) int {
+	for offset > 0 {
+		r, size := utf8.DecodeLastRuneInString(text[:offset])
+		offset -= size
+		if textCombining(r) {
+			continue
+		}
+		if joiner, _ := utf8.DecodeLastRuneInString(text[:offset]); joiner == textZeroWidthJoiner {
+			continue
+		}
+		break
+	}
+	return offset
+}
+
+// Get byte offset of the character after offset
+func textCharRight(text string, offset int) int {
+	if offset >= len(text) {
+		return len(text)
+	}
+	_, size := utf8.DecodeRuneInString(text[offset:])
+	offset += size
+
+	for offset < len(text) {
+		r, size := utf8.DecodeRuneInString(text[offset:])
+		if !textCombining(r) {
+			break
+		}
+		offset += size
+
+		if r == textZeroWidthJoiner && offset < len(text) {
+			_, size = utf8.DecodeRuneInString(text[offset:])
+			offset += size
+		}
+	}
+	return offset
+}
+
+// Zero width joiner, joins the characters around it into one
+const textZeroWidthJoiner = '\u200d'
+
+// Check if codepoint combines with the character before it
+func textCombining(r rune) bool {
+	return r == textZeroWidthJoiner || unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+// Get byte offset of the start of the word before offset
+func textWordLeft(text string, offset int) int {
+	for offset > 0 && text[offset-1] == ' ' {
+		offset--
+	}
+	for offset > 0 && text[offset-1] != ' ' {
+		offset--
+	}
+	return offset
+}
+
+// Get byte offset of the start of the word after offset
+func textWordRight(text string, offset int) int {
+	for offset < len(text) && text[offset] != ' ' {
+		offset++
+	}
+	for offset < len(text) && text[offset] == ' ' {
+		offset++
+	}
+	return offset
+}
+
+// Clamp byte offset to text, moving it back to the start of a character
+func textClampOffset(text string, offset int) int {
+	if offset < 0 {
+		return 0
+	}
+	if offset > len(text) {
+		return len(text)
+	}
+	for offset > 0 && offset < len(text) && !utf8.RuneStart(text[offset]) {
+		offset--
+	}
+	if start := textCharLeft(text, offset); textCharRight(text, start) != offset {
+		return start
+	}
+	return offset
+}
+
+// Get text box text drawing position, same as DrawText() for text without icon
+func (ctx *Context) textBoxTextPosition(bounds rl.Rectangle, text string) rl.Vector2 {
+	textBounds := ctx.GetTextBounds(TextBoxControl, bounds)
+	textWidth := ctx.GetTextWidth(text)
+	textHeight := int(ctx.GetStyle(Default, TextSizeProp))
+
+	position := rl.Vector2{X: textBounds.X, Y: textBounds.Y + textBounds.Height/2 - float32(textHeight/2) + float32(textValignPixelOffset(textBounds.Height))}
+	switch TextAlignment(ctx.GetStyle(TextBoxControl, TextAlignmentProp)) {
+	case TextAlignCenter:
+		position.X = textBounds.X + textBounds.Width/2 - float32(textWidth/2)
+	case TextAlignRight:
+		position.X = textBounds.X + textBounds.Width - float32(textWidth)
+	}
+
+	return rl.Vector2{X: floor32(position.X), Y: floor32(position.Y)}
+}
+
+// Get byte offset in text box text closest to the horizontal position x
+func (ctx *Context) textBoxOffsetAt(bounds rl.Rectangle, text string, x float32) int {
+	position := ctx.textBoxTextPosition(bounds, text)
+
+	return ctx.textLineOffsetAt(text, textLine{0, len(text)}, x-position.X)
+}
+
+// Draw text box text with selected text highlighted
+func (ctx *Context) textBoxDrawSelection(bounds rl.Rectangle, text string, e *textEdit, tint rl.Color) {
+	fontSize := float32(ctx.GetStyle(Default, TextSizeProp))
+	spacing := float32(ctx.GetStyle(Default, TextSpacingProp))
+	position := ctx.textBoxTextPosition(bounds, text)
+
+	// Text parts are drawn one after another, separated by glyph spacing
+	start, end := e.selection()
+	startX := position.X
+	if start > 0 {
+		startX += ctx.measureText(text[:start]) + spacing
+	}
+	endX := position.X + ctx.measureText(text[:end])
+
+	selection := rl.Rectangle{X: startX, Y: position.Y, Width: endX - startX, Height: fontSize}
+	ctx.DrawRectangle(selection, 0, rl.Blank, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, ColorSelectedBG))), ctx.alpha))
+
+	if start > 0 {
+		ctx.backend.DrawTextEx(ctx.font, text[:start], position, fontSize, spacing, tint)
+	}
+	ctx.backend.DrawTextEx(ctx.font, text[start:end], rl.Vector2{X: startX, Y: position.Y}, fontSize, spacing, rl.Fade(rl.GetColor(int32(ctx.GetStyle(TextBoxControl, ColorSelectedFG))), ctx.alpha))
+	if end < len(text) {
+		ctx.backend.DrawTextEx(ctx.font, text[end:], rl.Vector2{X: endX + spacing, Y: position.Y}, fontSize, spacing, tint)
+	}
+}
@@ -0,0 +1,328 @@
+package raygui
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+	"unicode"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestTextBoxEditing(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	// Edit text in a text box kept in edit mode, every step presses keys and types text
+	type step struct {
+		keys []int32
+		text string
+	}
+	edit := func(t *testing.T, b *HeadlessBackend, text string, steps ...step) string {
+		t.Helper()
+
+		// Text box not in edit mode first, so editing starts with cursor at the end
+		b.NextFrame()
+		TextBox(bounds, text, 64, false)
+		b.NextFrame()
+		text, _ = TextBox(bounds, text, 64, true)
+		for _, s := range steps {
+			pressKeys(b, s.keys...)
+			b.TypeText(s.text)
+			text, _ = TextBox(bounds, text, 64, true)
+		}
+		return text
+	}
+	keys := func(keys ...int32) step { return step{keys: keys} }
+	typed := func(text string) step { return step{text: text} }
+
+	tests := []struct {
+		name  string
+		text  string
+		steps []step
+		want  string
+	}{
+		{"home", "hello", []step{keys(rl.KeyHome), typed("X")}, "Xhello"},
+		{"left", "hello", []step{keys(rl.KeyLeft), keys(rl.KeyLeft), typed("Y")}, "helYlo"},
+		{"end", "hello", []step{keys(rl.KeyHome), keys(rl.KeyEnd), typed("!")}, "hello!"},
+		{"right stops at end", "ab", []step{keys(rl.KeyRight), typed("c")}, "abc"},
+		{"word left", "one two three", []step{keys(rl.KeyLeftControl, rl.KeyLeft), typed("_")}, "one two _three"},
+		{"word right", "one two three", []step{keys(rl.KeyHome), keys(rl.KeyLeftControl, rl.KeyRight), typed("_")}, "one _two three"},
+		{"backspace at cursor", "hello", []step{keys(rl.KeyLeft), keys(rl.KeyBackspace)}, "helo"},
+		{"backspace word", "one two", []step{keys(rl.KeyLeftControl, rl.KeyBackspace)}, "one "},
+		{"delete", "hello", []step{keys(rl.KeyHome), keys(rl.KeyDelete)}, "ello"},
+		{"delete word", "one two", []step{keys(rl.KeyHome), keys(rl.KeyLeftControl, rl.KeyDelete)}, "two"},
+		{"delete at end", "hello", []step{keys(rl.KeyDelete)}, "hello"},
+		{"multi-byte characters", "añb", []step{keys(rl.KeyLeft), keys(rl.KeyBackspace)}, "ab"},
+		{"cyrillic", "привет", []step{keys(rl.KeyLeftControl, rl.KeyLeft), keys(rl.KeyRight), keys(rl.KeyDelete)}, "пивет"},
+		{"cjk", "日本語", []step{keys(rl.KeyLeft), typed("の")}, "日本の語"},
+		{"combining mark moved over", "e\u0301x", []step{keys(rl.KeyLeft), keys(rl.KeyLeft), keys(rl.KeyRight), typed("_")}, "e\u0301_x"},
+		{"combining mark deleted", "ae\u0301", []step{keys(rl.KeyBackspace)}, "a"},
+		{"joined emoji deleted", "a👩\u200d💻", []step{keys(rl.KeyBackspace)}, "a"},
+		{"selection replaced", "one two", []step{keys(rl.KeyHome), keys(rl.KeyLeftShift, rl.KeyRight), keys(rl.KeyLeftShift, rl.KeyRight), typed("1")}, "1e two"},
+		{"selection deleted", "one two", []step{keys(rl.KeyLeftShift, rl.KeyLeftControl, rl.KeyLeft), keys(rl.KeyBackspace)}, "one "},
+		{"selection collapsed", "abc", []step{keys(rl.KeyLeftShift, rl.KeyHome), keys(rl.KeyRight), typed("_")}, "abc_"},
+		{"select all", "abc", []step{keys(rl.KeyLeftControl, rl.KeyA), typed("x")}, "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			if got := edit(t, b, tt.text, tt.steps...); got != tt.want {
+				t.Errorf("text = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("clipboard", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text := edit(t, b, "copy me", keys(rl.KeyLeftShift, rl.KeyLeftControl, rl.KeyLeft), keys(rl.KeyLeftControl, rl.KeyC))
+		if b.Clipboard != "me" || text != "copy me" {
+			t.Fatalf("clipboard, text = %q, %q after copy", b.Clipboard, text)
+		}
+
+		text = edit(t, b, text, keys(rl.KeyLeftControl, rl.KeyA), keys(rl.KeyLeftControl, rl.KeyX))
+		if b.Clipboard != "copy me" || text != "" {
+			t.Fatalf("clipboard, text = %q, %q after cut", b.Clipboard, text)
+		}
+
+		b.Clipboard = "pasted\nline"
+		text = edit(t, b, "[]", keys(rl.KeyLeft), keys(rl.KeyLeftControl, rl.KeyV))
+		if text != "[pastedline]" {
+			t.Errorf("text = %q after paste, want %q", text, "[pastedline]")
+		}
+	})
+
+	t.Run("selection drawn", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		edit(t, b, "abc", keys(rl.KeyLeftShift, rl.KeyLeft))
+
+		var found bool
+		for _, call := range b.DrawCallsOf(DrawRectangleCall) {
+			found = found || call.Colors[0] == rl.Fade(styleColor(TextBoxControl, ColorSelectedBG), 1)
+		}
+		if !found {
+			t.Error("selection background not drawn")
+		}
+
+		texts := b.Texts()
+		if len(texts) != 2 || texts[0] != "ab" || texts[1] != "c" {
+			t.Errorf("texts = %q, want unselected and selected parts", texts)
+		}
+		for _, call := range b.DrawCallsOf(DrawTextCall) {
+			if call.Text == "c" && call.Colors[0] != styleColor(TextBoxControl, ColorSelectedFG) {
+				t.Errorf("selected text color = %v, want %v", call.Colors[0], styleColor(TextBoxControl, ColorSelectedFG))
+			}
+		}
+	})
+
+	t.Run("click places cursor", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		// Click between "ab" and "cd" to start edit mode
+		position := DefaultContext().textBoxTextPosition(bounds, "abcd")
+		x := position.X + DefaultContext().measureText("ab") + 1
+
+		text, editMode := "abcd", false
+		frame := func() {
+			var pressed bool
+			if text, pressed = TextBox(bounds, text, 64, editMode); pressed {
+				editMode = !editMode
+			}
+		}
+		click(b, rl.Vector2{X: x, Y: 15}, frame)
+		b.NextFrame()
+		b.TypeText("_")
+		frame()
+		if text != "ab_cd" || !editMode {
+			t.Fatalf("text, editMode = %q, %v, want %q, true", text, editMode, "ab_cd")
+		}
+
+		// Click while editing moves the cursor
+		click(b, rl.Vector2{X: position.X, Y: 15}, frame)
+		b.NextFrame()
+		b.TypeText("^")
+		frame()
+		if text != "^ab_cd" {
+			t.Errorf("text = %q, want %q", text, "^ab_cd")
+		}
+	})
+
+	t.Run("held key repeats", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		text := edit(t, b, "0123456789")
+		for i := 0; i < 40; i++ {
+			b.NextFrame()
+			b.SetKey(rl.KeyBackspace, true)
+			text, _ = TextBox(bounds, text, 64, true)
+		}
+
+		// Held for 0.65s after the press: repeats at 0.5s, 0.55s, 0.6s and 0.65s
+		if text != "01234" {
+			t.Errorf("text = %q, want %q", text, "01234")
+		}
+	})
+}
+
+func TestTextBoxCharacterLimit(t *testing.T) {
+	b := newTestBackend(t)
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	// textSize 4 leaves room for 3 characters, however many bytes they take
+	text := "ñé"
+	TextBox(bounds, text, 4, false)
+	for _, typed := range []string{"ж", "x"} {
+		b.NextFrame()
+		b.TypeText(typed)
+		text, _ = TextBox(bounds, text, 4, true)
+	}
+	if text != "ñéж" {
+		t.Errorf("text = %q, want %q", text, "ñéж")
+	}
+
+	b.NextFrame()
+	text, cursor := "日本", len("日本")
+	for _, typed := range []string{"語", "x"} {
+		b.NextFrame()
+		b.TypeText(typed)
+		text, _ = TextBoxMulti(bounds, text, 4, nil, &cursor, true)
+	}
+	if text != "日本語" {
+		t.Errorf("multi-line text = %q, want %q", text, "日本語")
+	}
+}
+
+func TestTextCharacterOffsets(t *testing.T) {
+	text := "ae\u0301👩\u200d💻b"
+	want := []int{0, 1, 4, 15, 16}
+
+	offset := 0
+	for i := 1; i < len(want); i++ {
+		if offset = textCharRight(text, offset); offset != want[i] {
+			t.Fatalf("right %d: offset = %d, want %d", i, offset, want[i])
+		}
+	}
+	for i := len(want) - 2; i >= 0; i-- {
+		if offset = textCharLeft(text, offset); offset != want[i] {
+			t.Fatalf("left %d: offset = %d, want %d", i, offset, want[i])
+		}
+	}
+
+	// Offsets inside a character move back to its start
+	for offset, want := range map[int]int{2: 1, 3: 1, 4: 4, 9: 4, 16: 16} {
+		if got := textClampOffset(text, offset); got != want {
+			t.Errorf("textClampOffset(%d) = %d, want %d", offset, got, want)
+		}
+	}
+}
+
+func TestTextSplitMultiByte(t *testing.T) {
+	text := strings.Repeat("a", TextSplitMaxTextLength-1) + "é"
+
+	var count int
+	items := TextSplit(text, &count, nil)
+	if count != 1 || items[0] != strings.Repeat("a", TextSplitMaxTextLength-1) {
+		t.Errorf("items = %q, want text cut before the multi-byte character", items[:count])
+	}
+}
+
+func TestTextBoxOptions(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	// Type text into a text box in edit mode, one character per frame
+	typeText := func(b *HeadlessBackend, text string, options TextBoxOptions, typed string) string {
+		b.NextFrame()
+		TextBoxPro(bounds, text, options, false)
+		for _, r := range typed {
+			b.NextFrame()
+			b.TypeText(string(r))
+			text, _ = TextBoxPro(bounds, text, options, true)
+		}
+		return text
+	}
+
+	tests := []struct {
+		name    string
+		options TextBoxOptions
+		text    string
+		typed   string
+		want    string
+	}{
+		{"integer", TextBoxOptions{Filter: TextFilterInteger}, "", "-1a2.3", "-123"},
+		{"float", TextBoxOptions{Filter: TextFilterFloat}, "", "1.5.2e-3x", "1.52e-3"},
+		{"hex", TextBoxOptions{Filter: TextFilterHex}, "", "0xFfg9", "0Ff9"},
+		{"regexp", TextBoxOptions{Filter: TextFilterRegexp(regexp.MustCompile(`^[a-z]{0,3}$`))}, "", "abCde", "abd"},
+		{"runes", TextBoxOptions{Filter: TextFilterRunes(unicode.IsUpper)}, "", "aBcD", "BD"},
+		{"max runes", TextBoxOptions{MaxRunes: 3}, "日本", "語x", "日本語"},
+		{"password", TextBoxOptions{Password: true}, "pa", "ss", "pass"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t)
+
+			if got := typeText(b, tt.text, tt.options, tt.typed); got != tt.want {
+				t.Errorf("text = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("filtered paste", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		b.Clipboard = "1,024"
+		text := typeText(b, "", TextBoxOptions{Filter: TextFilterInteger}, "")
+		pressKeys(b, rl.KeyLeftControl, rl.KeyV)
+		if text, _ = TextBoxPro(bounds, text, TextBoxOptions{Filter: TextFilterInteger}, true); text != "1024" {
+			t.Errorf("text = %q, want %q", text, "1024")
+		}
+	})
+
+	t.Run("password masked", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		options := TextBoxOptions{Password: true}
+		text := typeText(b, "sécret", options, "")
+		if texts := b.Texts(); len(texts) != 1 || texts[0] != "******" {
+			t.Fatalf("texts = %q, want masked text", texts)
+		}
+
+		// Copying password text is disabled
+		pressKeys(b, rl.KeyLeftControl, rl.KeyA)
+		TextBoxPro(bounds, text, options, true)
+		pressKeys(b, rl.KeyLeftControl, rl.KeyC)
+		TextBoxPro(bounds, text, options, true)
+		if b.Clipboard != "" {
+			t.Errorf("clipboard = %q, want nothing copied", b.Clipboard)
+		}
+
+		// Clicking places the cursor between masked characters
+		pressKeys(b)
+		position := DefaultContext().textBoxTextPosition(bounds, "******")
+		click(b, rl.Vector2{X: position.X + DefaultContext().measureText("**") + 1, Y: 15}, func() { TextBoxPro(bounds, text, options, true) })
+		b.NextFrame()
+		b.TypeText("_")
+		if text, _ = TextBoxPro(bounds, text, options, true); text != "sé_cret" {
+			t.Errorf("text = %q, want %q", text, "sé_cret")
+		}
+	})
+
+	t.Run("placeholder", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		options := TextBoxOptions{Placeholder: "Search"}
+		b.NextFrame()
+		TextBoxPro(bounds, "", options, false)
+		calls := b.DrawCallsOf(DrawTextCall)
+		if len(calls) != 1 || calls[0].Text != "Search" || calls[0].Colors[0] != styleColor(TextBoxControl, TextColorDisabledProp) {
+			t.Fatalf("text calls = %+v, want placeholder in disabled text color", calls)
+		}
+
+		b.NextFrame()
+		TextBoxPro(bounds, "a", options, false)
+		if texts := b.Texts(); len(texts) != 1 || texts[0] != "a" {
+			t.Errorf("texts = %q, want placeholder hidden", texts)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package raygui
+
+import (
+	"regexp"
+)
+
+// Text box input filter, checks the text resulting from inserting a character
+// NOTE: Filters only reject inserted characters, deleting text is always allowed,
+// so filters have to accept incomplete input like "-" for numbers
+type TextFilter func(text string) bool
+
+var (
+	textFilterInteger = regexp.MustCompile(`^[-+]?[0-9]*$`)
+	textFilterFloat   = regexp.MustCompile(`^[-+]?[0-9]*\.?[0-9]*([eE][-+]?[0-9]*)?$`)
+	textFilterHex     = regexp.MustCompile(`^[0-9a-fA-F]*$`)
+)
+
+// Accept integer numbers with optional sign
+func TextFilterInteger(text string) bool {
+	return textFilterInteger.MatchString(text)
+}
+
+// Accept decimal numbers with optional sign, fraction and exponent
+func TextFilterFloat(text string) bool {
+	return textFilterFloat.MatchString(text)
+}
+
+// Accept hexadecimal digits
+func TextFilterHex(text string) bool {
+	return textFilterHex.MatchString(text)
+}
+
+// Create filter accepting text matched by a regular expression
+// NOTE: Use anchors (^ and $) to match the whole text
+func TextFilterRegexp(re *regexp.Regexp) TextFilter {
+	return re.MatchString
+}
+
+// Create filter accepting text made of characters accepted by a function
+func TextFilterRunes(accept func(r rune) bool) TextFilter {
+	return func(text string) bool {
+		for _, r := range text {
+			if !accept(r) {
+				return false
+			}
+		}
+		return true
+	}
+}
@@ -0,0 +1,156 @@
+package raygui
+
+import (
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+// Maximum number of undo steps kept for every control
+const UndoMaxSteps = 100
+
+// Control value saved in undo history
+// NOTE: Text controls use Text and Cursor, value controls use Value
+type UndoState struct {
+	Text   string  // Text of text controls
+	Cursor int     // Cursor position, byte offset into Text
+	Value  float64 // Value of value controls, integer values are kept exactly
+}
+
+// Undoable edit made with a control
+type UndoEdit struct {
+	ID      ID           // Control edited, 0 for controls without ID
+	Control Control      // Type of control edited
+	Bounds  rl.Rectangle // Bounds of control edited
+	Before  UndoState    // Control value before the edit
+	After   UndoState    // Control value after the edit
+	Merge   bool         // Edit continues the previous one, e.g. consecutive typing
+}
+
+// Undo history handler, called for every edit made with a control, including
+// undo and redo with Ctrl+Z/Ctrl+Y. Return true if the edit is kept in an
+// application undo history, so it is not added to the control undo stack.
+// NOTE: Handlers keeping their own history should replace the After state of
+// their last step with edits that have Merge set.
+type UndoHandler func(edit UndoEdit) bool
+
+// Undo history
+// NOTE: Every control keeps its own undo stack, controls with ID by ID, other
+// controls by type and bounds, so moving or resizing them starts a new history
+//
+// While a control is in edit mode, Ctrl+Z undoes the last edit and Ctrl+Y or
+// Ctrl+Shift+Z redoes it. Consecutive typing is undone in one step, moving
+// the cursor or any other edit starts a new step.
+
+// Undo stack of a control
+type undoStack struct {
+	undo   []UndoState // States before undoable edits, last is most recent
+	redo   []UndoState // States before undone edits, last is most recent
+	typing bool        // Last edit was typing, next typing merges into it
+}
+
+// Control without ID owning an undo stack
+type undoKey struct {
+	control Control
+	bounds  rl.Rectangle
+}
+
+// Get undo stack of control, kept by ID for the ID-based control being drawn,
+// by type and bounds for controls without ID
+func (ctx *Context) undoStackOf(control Control, bounds rl.Rectangle) *undoStack {
+	if ctx.undoID != 0 {
+		return &ctx.idState(ctx.undoID).undo
+	}
+
+	if ctx.undos == nil {
+		ctx.undos = map[undoKey]*undoStack{}
+	}
+
+	key := undoKey{control, bounds}
+	s, ok := ctx.undos[key]
+	if !ok {
+		s = &undoStack{}
+		ctx.undos[key] = s
+	}
+	return s
+}
+
+// Record edit of control, consecutive typing is merged into a single step
+func (ctx *Context) undoRecord(control Control, bounds rl.Rectangle, before, after UndoState, typing bool) {
+	if ctx.undoDisabled {
+		return
+	}
+
+	s := ctx.undoStackOf(control, bounds)
+	merge := typing && s.typing
+	s.typing = typing
+
+	if ctx.undoHandler != nil && ctx.undoHandler(UndoEdit{ID: ctx.undoID, Control: control, Bounds: bounds, Before: before, After: after, Merge: merge}) {
+		return
+	}
+
+	if !merge || len(s.undo) == 0 {
+		s.undo = append(s.undo, before)
+		if len(s.undo) > UndoMaxSteps {
+			s.undo = s.undo[1:]
+		}
+	}
+	s.redo = s.redo[:0]
+}
+
+// End merging of typing into last undo step of control, e.g. when the cursor moves
+func (ctx *Context) undoBreak(control Control, bounds rl.Rectangle) {
+	if !ctx.undoDisabled {
+		ctx.undoStackOf(control, bounds).typing = false
+	}
+}
+
+// Undo or redo last edit of control when Ctrl+Z or Ctrl+Y are pressed,
+// returns the state to restore
+func (ctx *Context) undoKeys(control Control, bounds rl.Rectangle, current UndoState) (UndoState, bool) {
+	if ctx.undoDisabled {
+		return current, false
+	}
+
+	ctrl := ctx.backend.IsKeyDown(rl.KeyLeftControl) || ctx.backend.IsKeyDown(rl.KeyRightControl)
+	shift := ctx.backend.IsKeyDown(rl.KeyLeftShift) || ctx.backend.IsKeyDown(rl.KeyRightShift)
+	if !ctrl {
+		return current, false
+	}
+
+	s := ctx.undoStackOf(control, bounds)
+	from, to := &s.undo, &s.redo
+	switch {
+	case ctx.backend.IsKeyPressed(rl.KeyZ) && !shift:
+	case ctx.backend.IsKeyPressed(rl.KeyY), ctx.backend.IsKeyPressed(rl.KeyZ):
+		from, to = &s.redo, &s.undo
+	default:
+		return current, false
+	}
+
+	if len(*from) == 0 {
+		return current, false
+	}
+
+	state := (*from)[len(*from)-1]
+	*from = (*from)[:len(*from)-1]
+	*to = append(*to, current)
+	s.typing = false
+
+	if ctx.undoHandler != nil {
+		ctx.undoHandler(UndoEdit{ID: ctx.undoID, Control: control, Bounds: bounds, Before: current, After: state})
+	}
+
+	return state, true
+}
+
+// Forget undo history of all controls
+func (ctx *Context) ClearUndo() {
+	ctx.undos = nil
+	for _, s := range ctx.ids {
+		s.undo = undoStack{}
+	}
+}
+
+// Set undo history handler, nil keeps edits only in control undo stacks
+func (ctx *Context) SetUndoHandler(handler UndoHandler) {
+	ctx.undoHandler = handler
+}
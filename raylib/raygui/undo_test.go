@@ -0,0 +1,187 @@
+package raygui
+
+import (
+	"testing"
+
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+func TestTextBoxUndo(t *testing.T) {
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	// Text box kept in edit mode, every step presses keys or types one character
+	type step struct {
+		keys []int32
+		text string
+		want string
+	}
+	run := func(t *testing.T, text string, steps ...step) {
+		t.Helper()
+		b := newTestBackend(t)
+
+		b.NextFrame()
+		TextBox(bounds, text, 64, false)
+		for i, s := range steps {
+			pressKeys(b, s.keys...)
+			b.TypeText(s.text)
+			if text, _ = TextBox(bounds, text, 64, true); text != s.want {
+				t.Fatalf("step %d: text = %q, want %q", i, text, s.want)
+			}
+		}
+	}
+	typed := func(text, want string) step { return step{text: text, want: want} }
+	undo := func(want string) step { return step{keys: []int32{rl.KeyLeftControl, rl.KeyZ}, want: want} }
+	redo := func(want string) step { return step{keys: []int32{rl.KeyLeftControl, rl.KeyY}, want: want} }
+
+	t.Run("typing undone in one step", func(t *testing.T) {
+		run(t, "x", typed("a", "xa"), typed("b", "xab"), typed("c", "xabc"), undo("x"), undo("x"), redo("xabc"), redo("xabc"))
+	})
+
+	t.Run("moving cursor starts new step", func(t *testing.T) {
+		run(t, "", typed("a", "a"), typed("b", "ab"), step{keys: []int32{rl.KeyLeft}, want: "ab"}, typed("x", "axb"), undo("ab"), undo(""))
+	})
+
+	t.Run("deleting is a separate step", func(t *testing.T) {
+		run(t, "hello", step{keys: []int32{rl.KeyBackspace}, want: "hell"}, typed("p", "hellp"), undo("hell"), undo("hello"), redo("hell"))
+	})
+
+	t.Run("ctrl+shift+z redoes", func(t *testing.T) {
+		run(t, "", typed("a", "a"), undo(""), step{keys: []int32{rl.KeyLeftControl, rl.KeyLeftShift, rl.KeyZ}, want: "a"})
+	})
+
+	t.Run("new edit clears redo", func(t *testing.T) {
+		run(t, "", typed("a", "a"), undo(""), typed("b", "b"), redo("b"))
+	})
+}
+
+func TestValueUndo(t *testing.T) {
+	undoKeys := []int32{rl.KeyLeftControl, rl.KeyZ}
+
+	t.Run("value box", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		value := 5
+		for _, typed := range "12" {
+			b.NextFrame()
+			b.TypeText(string(typed))
+			ValueBox(rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}, "", &value, 0, 1000, true)
+		}
+		if value != 512 {
+			t.Fatalf("value = %d, want 512", value)
+		}
+
+		pressKeys(b, undoKeys...)
+		ValueBox(rl.Rectangle{X: 0, Y: 0, Width: 100, Height: 20}, "", &value, 0, 1000, true)
+		if value != 5 {
+			t.Errorf("value = %d after undo, want 5", value)
+		}
+	})
+
+	t.Run("spinner", func(t *testing.T) {
+		b := newTestBackend(t)
+
+		bounds := rl.Rectangle{X: 0, Y: 0, Width: 120, Height: 20}
+		rightArrow := rl.Vector2{X: 115, Y: 10}
+		value := 1
+		frame := func() { Spinner(bounds, "", &value, 0, 10, true) }
+
+		click(b, rightArrow, frame)
+		click(b, rightArrow, frame)
+		if value != 3 {
+			t.Fatalf("value = %d, want 3", value)
+		}
+
+		// Every arrow click is its own step
+		pressKeys(b, undoKeys...)
+		frame()
+		if value != 2 {
+			t.Errorf("value = %d after undo, want 2", value)
+		}
+	})
+}
+
+func TestUndoID(t *testing.T) {
+	b := newTestBackend(t)
+
+	first, second := "", ""
+	frame := func() {
+		first, _ = TextBoxID(NewID("first"), rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 30}, first, 64)
+		second, _ = TextBoxID(NewID("second"), rl.Rectangle{X: 0, Y: 40, Width: 200, Height: 30}, second, 64)
+	}
+	edit := func(id string, text string) {
+		SetFocusedID(NewID(id))
+		b.NextFrame()
+		b.TypeText(text)
+		frame()
+	}
+
+	// Editing another text box keeps the history of the first one
+	edit("first", "a")
+	edit("second", "b")
+	SetFocusedID(NewID("first"))
+	pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+	frame()
+	if first != "" || second != "b" {
+		t.Errorf("first, second = %q, %q, want first undone", first, second)
+	}
+}
+
+func TestUndoWithoutID(t *testing.T) {
+	b := newTestBackend(t)
+	firstBounds := rl.Rectangle{X: 0, Y: 0, Width: 200, Height: 30}
+	secondBounds := rl.Rectangle{X: 0, Y: 40, Width: 200, Height: 30}
+
+	first, second := "", ""
+	edit := func(text *string, bounds rl.Rectangle, typed string) {
+		inputFrame(b, func() { b.TypeText(typed) })
+		*text, _ = TextBox(bounds, *text, 64, true)
+	}
+
+	// Editing another text box keeps the history of the first one
+	edit(&first, firstBounds, "a")
+	edit(&second, secondBounds, "b")
+	pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+	first, _ = TextBox(firstBounds, first, 64, true)
+	if first != "" || second != "b" {
+		t.Errorf("first, second = %q, %q, want first undone", first, second)
+	}
+
+	edit(&first, firstBounds, "c")
+	pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+	second, _ = TextBox(secondBounds, second, 64, true)
+	if first != "c" || second != "" {
+		t.Errorf("first, second = %q, %q, want second undone", first, second)
+	}
+}
+
+func TestUndoHandler(t *testing.T) {
+	b := newTestBackend(t)
+	bounds := rl.Rectangle{X: 0, Y: 0, Width: 300, Height: 30}
+
+	var edits []UndoEdit
+	SetUndoHandler(func(edit UndoEdit) bool {
+		edits = append(edits, edit)
+		return true
+	})
+
+	text := ""
+	TextBox(bounds, text, 64, false)
+	for _, typed := range "ab" {
+		b.NextFrame()
+		b.TypeText(string(typed))
+		text, _ = TextBox(bounds, text, 64, true)
+	}
+
+	if len(edits) != 2 || edits[0].Merge || !edits[1].Merge {
+		t.Fatalf("edits = %+v, want typing merged into first edit", edits)
+	}
+	if edits[1].Control != TextBoxControl || edits[1].Bounds != bounds || edits[1].After.Text != "ab" {
+		t.Errorf("edit = %+v, want text box edit ending with %q", edits[1], "ab")
+	}
+
+	// Edits kept by the handler are not in the text box undo stack
+	pressKeys(b, rl.KeyLeftControl, rl.KeyZ)
+	if text, _ = TextBox(bounds, text, 64, true); text != "ab" {
+		t.Errorf("text = %q after undo, want %q", text, "ab")
+	}
+}
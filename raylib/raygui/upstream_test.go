@@ -0,0 +1,502 @@
+package raygui
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bvisness/jamtech/raylib/raygui/internal/craygui"
+	rl "github.com/gen2brain/raylib-go/raylib"
+)
+
+var upstreamRenderDir = flag.String("upstream.render", "", "render last frame of every upstream scenario to PNG files in this directory")
+
+// Gui functions used by upstream scenarios, implemented by the Go port and by raygui.h
+type upstreamGui struct {
+	Disable     func()
+	Lock        func()
+	Fade        func(alpha float32)
+	SetStyle    func(control Control, property ControlProperty, value uint)
+	WindowBox   func(bounds rl.Rectangle, title string) bool
+	GroupBox    func(bounds rl.Rectangle, text string)
+	Line        func(bounds rl.Rectangle, text string)
+	Panel       func(bounds rl.Rectangle)
+	ScrollPanel func(bounds, content rl.Rectangle, scroll *rl.Vector2) rl.Rectangle
+	Label       func(bounds rl.Rectangle, text string)
+	Button      func(bounds rl.Rectangle, text string) bool
+	LabelButton func(bounds rl.Rectangle, text string) bool
+	Toggle      func(bounds rl.Rectangle, text string, active bool) bool
+	ToggleGroup func(bounds rl.Rectangle, text string, active int) int
+	CheckBox    func(bounds rl.Rectangle, text string, checked bool) bool
+	ComboBox    func(bounds rl.Rectangle, text string, active int) int
+	DropdownBox func(bounds rl.Rectangle, text string, active *int, editMode bool) bool
+	Spinner     func(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool
+	ValueBox    func(bounds rl.Rectangle, text string, value *int, minValue, maxValue int, editMode bool) bool
+	TextBox     func(bounds rl.Rectangle, text string, textSize int, editMode bool) (string, bool)
+	Slider      func(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32
+	SliderBar   func(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32
+	ProgressBar func(bounds rl.Rectangle, textLeft, textRight string, value, minValue, maxValue float32) float32
+	StatusBar   func(bounds rl.Rectangle, text string)
+	ScrollBar   func(bounds rl.Rectangle, value, minValue, maxValue int) int
+	Grid        func(bounds rl.Rectangle, spacing float32, subdivs int) rl.Vector2
+	ListView    func(bounds rl.Rectangle, text string, scrollIndex *int, active int) int
+	MessageBox  func(bounds rl.Rectangle, title, message, buttons string) int
+	ColorPicker func(bounds rl.Rectangle, color rl.Color) rl.Color
+	IconText    func(icon Icon, text string) string
+}
+
+var goGui = upstreamGui{
+	Disable: Disable, Lock: Lock, Fade: Fade, SetStyle: SetStyle,
+	WindowBox: WindowBox, GroupBox: GroupBox, Line: Line, Panel: Panel, ScrollPanel: ScrollPanel,
+	Label: Label, Button: Button, LabelButton: LabelButton, Toggle: Toggle, ToggleGroup: ToggleGroup,
+	CheckBox: CheckBox, ComboBox: ComboBox, DropdownBox: DropdownBox, Spinner: Spinner, ValueBox: ValueBox,
+	TextBox: TextBox, Slider: Slider, SliderBar: SliderBar, ProgressBar: ProgressBar, StatusBar: StatusBar,
+	ScrollBar: ScrollBar, Grid: Grid, ListView: ListView, MessageBox: MessageBox, ColorPicker: ColorPicker,
+	IconText: IconText,
+}
+
+var cGui = upstreamGui{
+	Disable: craygui.Disable, Lock: craygui.Lock, Fade: craygui.Fade,
+	SetStyle: func(control Control, property ControlProperty, value uint) {
+		craygui.SetStyle(int(control), int(property), value)
+	},
+	WindowBox: craygui.WindowBox, GroupBox: craygui.GroupBox, Line: craygui.Line, Panel: craygui.Panel, ScrollPanel: craygui.ScrollPanel,
+	Label: craygui.Label, Button: craygui.Button, LabelButton: craygui.LabelButton, Toggle: craygui.Toggle, ToggleGroup: craygui.ToggleGroup,
+	CheckBox: craygui.CheckBox, ComboBox: craygui.ComboBox, DropdownBox: craygui.DropdownBox, Spinner: craygui.Spinner, ValueBox: craygui.ValueBox,
+	TextBox: craygui.TextBox, Slider: craygui.Slider, SliderBar: craygui.SliderBar, ProgressBar: craygui.ProgressBar, StatusBar: craygui.StatusBar,
+	ScrollBar: craygui.ScrollBar, Grid: craygui.Grid, ListView: craygui.ListView, MessageBox: craygui.MessageBox, ColorPicker: craygui.ColorPicker,
+	IconText: func(icon Icon, text string) string {
+		return craygui.IconText(int(icon), text)
+	},
+}
+
+// Scripted input for one frame
+type upstreamInput struct {
+	Mouse rl.Vector2 // Mouse position
+	Down  bool       // Left mouse button state
+	Keys  []int32    // Keys held down
+	Text  string     // Characters typed
+	Wheel int32      // Mouse wheel movement
+}
+
+// Scenario rendered through both implementations, one call to draw per input frame
+// NOTE: setup returns the frame function so every run keeps its own control state
+type upstreamScenario struct {
+	name   string
+	frames []upstreamInput
+	setup  func() func(g upstreamGui)
+}
+
+// Known differences between the Go port and raygui.h, by scenario name
+// NOTE: Scenarios listed here must differ, so fixed divergences are noticed
+var upstreamDivergences = map[string]string{
+	"ProgressBarMinimum": "ProgressBar() subtracts minValue before dividing by the range",
+	"SpinnerHold":        "Spinner() arrows repeat while held down",
+	"TextBoxTyping":      "TextBox() draws the cursor after this frame edits, raygui.h before them",
+	"ValueBoxNegate":     "ValueBox() negates the value when '-' is typed",
+}
+
+func frames(inputs ...upstreamInput) []upstreamInput {
+	return inputs
+}
+
+func at(x, y float32) rl.Vector2 {
+	return rl.Vector2{X: x, Y: y}
+}
+
+func rect(x, y, width, height float32) rl.Rectangle {
+	return rl.Rectangle{X: x, Y: y, Width: width, Height: height}
+}
+
+// Press and release left mouse button at position
+func clickAt(position rl.Vector2) []upstreamInput {
+	return frames(upstreamInput{Mouse: position, Down: true}, upstreamInput{Mouse: position})
+}
+
+// Hold left mouse button down at position for a number of frames
+func holdAt(position rl.Vector2, count int) []upstreamInput {
+	inputs := make([]upstreamInput, count)
+	for i := range inputs {
+		inputs[i] = upstreamInput{Mouse: position, Down: true}
+	}
+	return inputs
+}
+
+// Frames function running the same draw function every frame
+func stateless(draw func(g upstreamGui)) func() func(g upstreamGui) {
+	return func() func(g upstreamGui) { return draw }
+}
+
+var upstreamScenarios = []upstreamScenario{
+	{"WindowBox", frames(upstreamInput{Mouse: at(185, 20)}), stateless(func(g upstreamGui) {
+		g.WindowBox(rect(10, 10, 180, 120), "Window")
+	})},
+	{"GroupBox", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.GroupBox(rect(10, 10, 180, 80), "Group")
+		g.GroupBox(rect(10, 100, 180, 80), "")
+	})},
+	{"Line", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.Line(rect(10, 10, 180, 12), "Section")
+		g.Line(rect(10, 30, 180, 12), "")
+	})},
+	{"Panel", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.Panel(rect(10, 10, 180, 80))
+	})},
+	{"Label", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.Label(rect(10, 10, 180, 20), "Label text")
+	})},
+	{"StatusBar", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.StatusBar(rect(0, 180, 200, 20), "Ready")
+	})},
+	{"Button", append(frames(upstreamInput{Mouse: at(300, 300)}, upstreamInput{Mouse: at(50, 20)}), clickAt(at(50, 20))...), stateless(func(g upstreamGui) {
+		g.Button(rect(10, 10, 80, 24), "Button")
+	})},
+	{"ButtonIcon", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.Button(rect(10, 10, 80, 24), g.IconText(IconFileSave, "Save"))
+	})},
+	{"LabelButton", append(frames(upstreamInput{Mouse: at(30, 20)}), clickAt(at(30, 20))...), stateless(func(g upstreamGui) {
+		g.LabelButton(rect(10, 10, 80, 24), "Link")
+	})},
+	{"Toggle", append(frames(upstreamInput{}, upstreamInput{Mouse: at(50, 20)}), clickAt(at(50, 20))...), func() func(g upstreamGui) {
+		active := false
+		return func(g upstreamGui) {
+			active = g.Toggle(rect(10, 10, 80, 24), "Toggle", active)
+		}
+	}},
+	{"ToggleGroup", clickAt(at(120, 20)), func() func(g upstreamGui) {
+		active := 0
+		return func(g upstreamGui) {
+			active = g.ToggleGroup(rect(10, 10, 60, 24), "One;Two;Three", active)
+		}
+	}},
+	{"CheckBox", append(frames(upstreamInput{}), clickAt(at(15, 15))...), func() func(g upstreamGui) {
+		checked := false
+		return func(g upstreamGui) {
+			checked = g.CheckBox(rect(10, 10, 12, 12), "Check", checked)
+		}
+	}},
+	{"ComboBox", append(clickAt(at(50, 20)), upstreamInput{}), func() func(g upstreamGui) {
+		active := 0
+		return func(g upstreamGui) {
+			active = g.ComboBox(rect(10, 10, 140, 24), "One;Two;Three", active)
+		}
+	}},
+	{"DropdownBox", append(append(clickAt(at(50, 20)), upstreamInput{Mouse: at(50, 70)}), clickAt(at(50, 70))...), func() func(g upstreamGui) {
+		active, editMode := 0, false
+		return func(g upstreamGui) {
+			if g.DropdownBox(rect(10, 10, 140, 24), "One;Two;Three", &active, editMode) {
+				editMode = !editMode
+			}
+		}
+	}},
+	{"TextBox", append(clickAt(at(50, 20)), upstreamInput{Mouse: at(50, 20)}, upstreamInput{Mouse: at(50, 20), Keys: []int32{rl.KeyEnter}}), func() func(g upstreamGui) {
+		text, editMode := "Edit", false
+		return func(g upstreamGui) {
+			var pressed bool
+			if text, pressed = g.TextBox(rect(10, 10, 140, 24), text, 32, editMode); pressed {
+				editMode = !editMode
+			}
+		}
+	}},
+	{"TextBoxTyping", append(clickAt(at(50, 20)), upstreamInput{Mouse: at(50, 20), Text: "abc"}, upstreamInput{Mouse: at(50, 20), Keys: []int32{rl.KeyBackspace}}, upstreamInput{Mouse: at(50, 20), Keys: []int32{rl.KeyEnter}}), func() func(g upstreamGui) {
+		text, editMode := "Edit", false
+		return func(g upstreamGui) {
+			var pressed bool
+			if text, pressed = g.TextBox(rect(10, 10, 140, 24), text, 32, editMode); pressed {
+				editMode = !editMode
+			}
+		}
+	}},
+	{"ValueBox", append(clickAt(at(100, 20)), upstreamInput{Mouse: at(100, 20), Text: "42"}, upstreamInput{Mouse: at(100, 20), Keys: []int32{rl.KeyEnter}}), func() func(g upstreamGui) {
+		value, editMode := 7, false
+		return func(g upstreamGui) {
+			if g.ValueBox(rect(60, 10, 100, 24), "Value", &value, 0, 100, editMode) {
+				editMode = !editMode
+			}
+		}
+	}},
+	{"Spinner", append(clickAt(at(150, 20)), clickAt(at(65, 20))...), func() func(g upstreamGui) {
+		value := 5
+		return func(g upstreamGui) {
+			g.Spinner(rect(60, 10, 100, 24), "Spin", &value, 0, 10, false)
+		}
+	}},
+	{"SpinnerHold", append(holdAt(at(150, 20), 40), upstreamInput{Mouse: at(150, 20)}), func() func(g upstreamGui) {
+		value := 5
+		return func(g upstreamGui) {
+			g.Spinner(rect(60, 10, 100, 24), "Spin", &value, 0, 10, false)
+		}
+	}},
+	{"ValueBoxNegate", append(clickAt(at(100, 20)), upstreamInput{Mouse: at(100, 20), Text: "-"}), func() func(g upstreamGui) {
+		value, editMode := 7, false
+		return func(g upstreamGui) {
+			if g.ValueBox(rect(60, 10, 100, 24), "Value", &value, -100, 100, editMode) {
+				editMode = !editMode
+			}
+		}
+	}},
+	{"Slider", frames(upstreamInput{Mouse: at(90, 20)}, upstreamInput{Mouse: at(90, 20), Down: true}, upstreamInput{Mouse: at(120, 20), Down: true}), func() func(g upstreamGui) {
+		value := float32(25)
+		return func(g upstreamGui) {
+			value = g.Slider(rect(50, 10, 120, 20), "Min", "Max", value, 0, 100)
+		}
+	}},
+	{"SliderBar", frames(upstreamInput{}, upstreamInput{Mouse: at(80, 20), Down: true}), func() func(g upstreamGui) {
+		value := float32(60)
+		return func(g upstreamGui) {
+			value = g.SliderBar(rect(50, 10, 120, 20), "Left", "Right", value, 0, 100)
+		}
+	}},
+	{"ProgressBar", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.ProgressBar(rect(50, 10, 120, 20), "0%", "100%", 40, 0, 100)
+	})},
+	{"ProgressBarMinimum", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.ProgressBar(rect(50, 10, 120, 20), "50", "100", 60, 50, 100)
+	})},
+	{"ScrollBar", append(append(frames(upstreamInput{Mouse: at(15, 60)}), clickAt(at(15, 15))...), clickAt(at(15, 105))...), func() func(g upstreamGui) {
+		value := 50
+		return func(g upstreamGui) {
+			value = g.ScrollBar(rect(10, 10, 12, 100), value, 0, 100)
+			g.ScrollBar(rect(30, 10, 100, 12), 20, 0, 100)
+		}
+	}},
+	{"ScrollPanel", frames(upstreamInput{Mouse: at(60, 60)}, upstreamInput{Mouse: at(60, 60), Wheel: -1}), func() func(g upstreamGui) {
+		scroll := rl.Vector2{}
+		return func(g upstreamGui) {
+			g.ScrollPanel(rect(10, 10, 120, 100), rect(0, 0, 240, 300), &scroll)
+		}
+	}},
+	{"ListView", append(frames(upstreamInput{Mouse: at(50, 40)}), clickAt(at(50, 40))...), func() func(g upstreamGui) {
+		scrollIndex, active := 0, -1
+		return func(g upstreamGui) {
+			active = g.ListView(rect(10, 10, 120, 100), "One;Two;Three;Four;Five;Six;Seven", &scrollIndex, active)
+		}
+	}},
+	{"MessageBox", frames(upstreamInput{Mouse: at(60, 100)}), stateless(func(g upstreamGui) {
+		g.MessageBox(rect(10, 10, 200, 120), "Title", "Message text", "Yes;No")
+	})},
+	{"ColorPicker", append(frames(upstreamInput{}), clickAt(at(60, 60))...), func() func(g upstreamGui) {
+		color := rl.Color{R: 200, G: 80, B: 40, A: 255}
+		return func(g upstreamGui) {
+			color = g.ColorPicker(rect(10, 10, 120, 120), color)
+		}
+	}},
+	{"Grid", frames(upstreamInput{Mouse: at(55, 45)}), stateless(func(g upstreamGui) {
+		g.Grid(rect(10, 10, 120, 80), 40, 2)
+	})},
+	{"Disabled", frames(upstreamInput{Mouse: at(50, 20), Down: true}), stateless(func(g upstreamGui) {
+		g.Disable()
+		g.Button(rect(10, 10, 80, 24), "Button")
+		g.CheckBox(rect(10, 40, 12, 12), "Check", true)
+		g.Toggle(rect(10, 60, 80, 24), "Toggle", false)
+	})},
+	{"Locked", frames(upstreamInput{Mouse: at(50, 20), Down: true}), stateless(func(g upstreamGui) {
+		g.Lock()
+		g.Button(rect(10, 10, 80, 24), "Button")
+	})},
+	{"Fade", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.Fade(0.5)
+		g.Button(rect(10, 10, 80, 24), "Button")
+		g.Label(rect(10, 40, 80, 24), "Label")
+	})},
+	{"Style", frames(upstreamInput{}), stateless(func(g upstreamGui) {
+		g.SetStyle(Default, TextSizeProp, 20)
+		g.SetStyle(ButtonControl, BorderWidthProp, 3)
+		g.SetStyle(ButtonControl, TextAlignmentProp, uint(TextAlignLeft))
+		g.Button(rect(10, 10, 120, 30), "Styled")
+	})},
+}
+
+// Render scenario through the Go port, recording draw calls of every frame
+func renderPort(t *testing.T, scenario upstreamScenario) [][]DrawCall {
+	b := newTestBackend(t)
+
+	// raygui.h draws no icon pixels in standalone mode, icons layout is still compared
+	defaultContext.icons = [RIconMaxIcons * RIconDataElements]uint32{}
+
+	draw := scenario.setup()
+	var recorded [][]DrawCall
+	for _, input := range scenario.frames {
+		b.NextFrame()
+		b.MousePosition = input.Mouse
+		b.MouseWheelMove = input.Wheel
+		b.SetMouseButton(rl.MouseLeftButton, input.Down)
+		for _, key := range []int32{rl.KeyBackspace, rl.KeyEnter} {
+			b.SetKey(key, false)
+		}
+		for _, key := range input.Keys {
+			b.SetKey(key, true)
+		}
+		b.TypeText(input.Text)
+
+		draw(goGui)
+
+		// raygui.h draws vertical gradients as DrawRectangleGradientEx()
+		var calls []DrawCall
+		for _, call := range b.DrawCalls {
+			if call.Kind == DrawRectangleGradientVCall {
+				call.Kind = DrawRectangleGradientExCall
+				call.Colors[2], call.Colors[3] = call.Colors[1], call.Colors[0]
+			}
+			calls = append(calls, call)
+		}
+		recorded = append(recorded, calls)
+	}
+	return recorded
+}
+
+// Render scenario through raygui.h, recording draw calls of every frame
+func renderUpstream(scenario upstreamScenario) [][]DrawCall {
+	craygui.Reset()
+	craygui.Enable()
+	craygui.Unlock()
+	craygui.Fade(1)
+	craygui.LoadStyleDefault()
+
+	draw := scenario.setup()
+	var recorded [][]DrawCall
+	for _, input := range scenario.frames {
+		craygui.NextFrame()
+		craygui.SetMousePosition(input.Mouse)
+		craygui.SetMouseWheelMove(input.Wheel)
+		craygui.SetMouseButton(rl.MouseLeftButton, input.Down)
+		for _, key := range []int32{rl.KeyBackspace, rl.KeyEnter} {
+			craygui.SetKey(key, false)
+		}
+		for _, key := range input.Keys {
+			craygui.SetKey(key, true)
+		}
+		craygui.TypeText(input.Text)
+
+		draw(cGui)
+
+		var calls []DrawCall
+		for _, call := range craygui.DrawCalls() {
+			calls = append(calls, DrawCall{
+				Kind:     DrawCallKind(call.Kind),
+				Rec:      call.Rec,
+				Position: call.Position,
+				Points:   call.Points,
+				Colors:   call.Colors,
+				Text:     call.Text,
+				FontSize: call.FontSize,
+				Spacing:  call.Spacing,
+				Texture:  call.Texture,
+			})
+		}
+		recorded = append(recorded, calls)
+	}
+	return recorded
+}
+
+func formatDrawCall(call DrawCall) string {
+	switch call.Kind {
+	case DrawRectangleCall:
+		return fmt.Sprintf("DrawRectangle(%v, %v)", call.Rec, call.Colors[0])
+	case DrawRectangleGradientVCall, DrawRectangleGradientExCall:
+		return fmt.Sprintf("DrawRectangleGradientEx(%v, %v)", call.Rec, call.Colors)
+	case DrawTriangleCall:
+		return fmt.Sprintf("DrawTriangle(%v, %v)", call.Points, call.Colors[0])
+	case DrawTextureRecCall:
+		return fmt.Sprintf("DrawTextureRec(%d, %v, %v, %v)", call.Texture.ID, call.Rec, call.Position, call.Colors[0])
+	case DrawTextCall:
+		return fmt.Sprintf("DrawTextEx(%q, %v, %v, %v, %v)", call.Text, call.Position, call.FontSize, call.Spacing, call.Colors[0])
+	case BeginScissorModeCall:
+		return fmt.Sprintf("BeginScissorMode(%v)", call.Rec)
+	case EndScissorModeCall:
+		return "EndScissorMode()"
+	}
+	return fmt.Sprintf("%+v", call)
+}
+
+// Diff draw call streams, one line per differing call
+func diffDrawCalls(upstream, port [][]DrawCall) []string {
+	var diff []string
+	for frame := range upstream {
+		want, got := upstream[frame], port[frame]
+
+		for i := 0; i < len(want) || i < len(got); i++ {
+			switch {
+			case i >= len(got):
+				diff = append(diff, fmt.Sprintf("frame %d call %d: missing %s", frame, i, formatDrawCall(want[i])))
+			case i >= len(want):
+				diff = append(diff, fmt.Sprintf("frame %d call %d: extra %s", frame, i, formatDrawCall(got[i])))
+			case want[i] != got[i]:
+				diff = append(diff, fmt.Sprintf("frame %d call %d:\n\t  C: %s\n\t Go: %s", frame, i, formatDrawCall(want[i]), formatDrawCall(got[i])))
+			}
+		}
+	}
+	return diff
+}
+
+// Replay draw calls on a software backend and save the image
+func renderPNG(fileName string, calls []DrawCall) error {
+	b := NewSoftwareBackend(240, 200)
+	b.Clear(rl.GetColor(int32(GetStyle(Default, BackgroundColorProp))))
+
+	for _, call := range calls {
+		switch call.Kind {
+		case DrawRectangleCall:
+			b.DrawRectangle(int32(call.Rec.X), int32(call.Rec.Y), int32(call.Rec.Width), int32(call.Rec.Height), call.Colors[0])
+		case DrawRectangleGradientVCall, DrawRectangleGradientExCall:
+			b.DrawRectangleGradientEx(call.Rec, call.Colors[0], call.Colors[1], call.Colors[2], call.Colors[3])
+		case DrawTriangleCall:
+			b.DrawTriangle(call.Points[0], call.Points[1], call.Points[2], call.Colors[0])
+		case DrawTextureRecCall:
+			b.DrawTextureRec(call.Texture, call.Rec, call.Position, call.Colors[0])
+		case DrawTextCall:
+			b.DrawTextEx(b.GetFontDefault(), call.Text, call.Position, call.FontSize, call.Spacing, call.Colors[0])
+		case BeginScissorModeCall:
+			b.BeginScissorMode(int32(call.Rec.X), int32(call.Rec.Y), int32(call.Rec.Width), int32(call.Rec.Height))
+		case EndScissorModeCall:
+			b.EndScissorMode()
+		}
+	}
+
+	file, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	if err := b.SavePNG(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+func TestUpstream(t *testing.T) {
+	for _, scenario := range upstreamScenarios {
+		scenario := scenario
+		t.Run(scenario.name, func(t *testing.T) {
+			upstream := renderUpstream(scenario)
+			port := renderPort(t, scenario)
+
+			if *upstreamRenderDir != "" {
+				last := len(scenario.frames) - 1
+				for _, render := range []struct {
+					suffix string
+					calls  []DrawCall
+				}{{"c", upstream[last]}, {"go", port[last]}} {
+					fileName := filepath.Join(*upstreamRenderDir, fmt.Sprintf("%s_%s.png", scenario.name, render.suffix))
+					if err := renderPNG(fileName, render.calls); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			diff := diffDrawCalls(upstream, port)
+			reason, known := upstreamDivergences[scenario.name]
+			switch {
+			case known && len(diff) == 0:
+				t.Errorf("known divergence (%s) now matches raygui.h, remove it from upstreamDivergences", reason)
+			case known:
+				t.Logf("known divergence: %s\n%s", reason, strings.Join(diff, "\n"))
+			case len(diff) > 0:
+				t.Errorf("draw calls differ from raygui.h:\n%s", strings.Join(diff, "\n"))
+			}
+		})
+	}
+}